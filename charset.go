@@ -0,0 +1,109 @@
+package testdata
+
+import (
+	"math/rand/v2"
+	"strings"
+	"unicode"
+)
+
+// Charset is the characters generated strings are made of.
+type Charset int
+
+const (
+	// Alphanumeric is the ASCII letters and digits. It is the default Charset.
+	Alphanumeric Charset = iota
+	// Letters is the ASCII letters.
+	Letters
+	// Digits is 0 to 9.
+	Digits
+	// Hex is the lower case hexadecimal digits.
+	Hex
+	// Base64 is the characters of the standard base64 encoding, without padding.
+	Base64
+	// Printable is the printable ASCII characters, including space.
+	Printable
+	// Unicode is any graphic Unicode character.
+	Unicode
+	// Emoji is pictographic symbols, ie 😀, outside of the Basic Multilingual Plane.
+	Emoji
+	// RTL is Hebrew and Arabic letters, which are written right to left.
+	RTL
+	// Combining is ASCII letters followed by one or two combining marks, ie é written as e and U+0301.
+	Combining
+)
+
+var (
+	alphanumericChars = []rune("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	letterChars       = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	digitChars        = []rune("0123456789")
+	hexChars          = []rune("0123456789abcdef")
+	base64Chars       = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/")
+
+	// The ranges are pairs of the first and last rune.
+	emojiRanges     = []rune{0x1F300, 0x1F3FA, 0x1F400, 0x1F5FF, 0x1F600, 0x1F64F, 0x1F680, 0x1F6FF, 0x1F900, 0x1F9FF}
+	rtlRanges       = []rune{0x05D0, 0x05EA, 0x0621, 0x063F, 0x0641, 0x064A}
+	combiningRanges = []rune{0x0300, 0x036F}
+)
+
+// chars returns size characters of the Charset. A character of Combining is more than one rune.
+func (c Charset) chars(r *rand.Rand, size int) string {
+	var b strings.Builder
+	for i := 0; i < size; i++ {
+		c.write(r, &b)
+	}
+
+	return b.String()
+}
+
+func (c Charset) write(r *rand.Rand, b *strings.Builder) {
+	switch c {
+	case Letters:
+		b.WriteRune(pickRune(r, letterChars))
+	case Digits:
+		b.WriteRune(pickRune(r, digitChars))
+	case Hex:
+		b.WriteRune(pickRune(r, hexChars))
+	case Base64:
+		b.WriteRune(pickRune(r, base64Chars))
+	case Printable:
+		b.WriteRune(rune(' ' + r.IntN('~'-' '+1)))
+	case Unicode:
+		b.WriteRune(graphicRune(r, []rune{' ', unicode.MaxRune}))
+	case Emoji:
+		b.WriteRune(graphicRune(r, emojiRanges))
+	case RTL:
+		b.WriteRune(graphicRune(r, rtlRanges))
+	case Combining:
+		b.WriteRune(pickRune(r, letterChars))
+		for i := 0; i <= r.IntN(2); i++ {
+			b.WriteRune(graphicRune(r, combiningRanges))
+		}
+	default:
+		b.WriteRune(pickRune(r, alphanumericChars))
+	}
+}
+
+func pickRune(r *rand.Rand, chars []rune) rune {
+	return chars[r.IntN(len(chars))]
+}
+
+// graphicRune picks a graphic rune from the ranges, skipping unassigned code points.
+func graphicRune(r *rand.Rand, ranges []rune) rune {
+	var total = 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+
+	for {
+		var n = rune(r.IntN(total))
+		for i := 0; i < len(ranges); i += 2 {
+			if c := ranges[i] + n; c <= ranges[i+1] {
+				if unicode.IsGraphic(c) {
+					return c
+				}
+				break
+			}
+			n -= ranges[i+1] - ranges[i] + 1
+		}
+	}
+}
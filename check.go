@@ -0,0 +1,195 @@
+package testdata
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kyuff/testdata/internal/shrink"
+)
+
+type checkingT interface {
+	testingT
+	errorT
+	Helper()
+}
+
+// CheckOption to customize a Check.
+type CheckOption func(opts *checkOptions)
+
+type checkOptions struct {
+	cases   int
+	shrinks int
+}
+
+// CheckCases sets the number of generated values the property is checked against.
+// The default is 100.
+func CheckCases(n int) CheckOption {
+	return func(opts *checkOptions) {
+		opts.cases = n
+	}
+}
+
+// CheckShrinks sets the maximum number of times a failing value is shrunk.
+// The default is 1000.
+func CheckShrinks(n int) CheckOption {
+	return func(opts *checkOptions) {
+		opts.shrinks = n
+	}
+}
+
+// Check runs a number of values T generated by DefaultConfig through the property.
+// If the property returns an error or panics for a value, the value is shrunk to a minimal
+// counterexample that is reported using t.Errorf.
+func Check[T any](t checkingT, property func(T) error, opts ...CheckOption) {
+	t.Helper()
+	CheckWith[T](t, DefaultConfig, property, opts...)
+}
+
+// CheckWith is similar to Check, just using cfg instead of DefaultConfig.
+func CheckWith[T any](t checkingT, cfg *Config, property func(T) error, opts ...CheckOption) {
+	t.Helper()
+	var options = checkOptions{
+		cases:   100,
+		shrinks: 1000,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	for i := 0; i < options.cases; i++ {
+		var value = MakeWith[T](t, cfg)
+		err := checkProperty(property, value)
+		if err == nil {
+			continue
+		}
+
+		minimal, minimalErr, shrinks := shrinkValue(property, value, err, options.shrinks)
+		t.Errorf("testdata: property failed after %d cases and %d shrinks\nvalue: %s\nerror: %s",
+			i+1, shrinks, formatValue(reflect.ValueOf(&minimal).Elem()), minimalErr)
+		return
+	}
+}
+
+// shrinkValue simplifies value for as long as the property keeps failing.
+func shrinkValue[T any](property func(T) error, value T, err error, limit int) (T, error, int) {
+	var (
+		current = reflect.ValueOf(&value).Elem()
+		shrinks = 0
+	)
+
+	for shrinks < limit {
+		var shrunk = false
+		for _, candidate := range shrink.Candidates(current) {
+			var v = candidate.Interface().(T)
+			if candidateErr := checkProperty(property, v); candidateErr != nil {
+				current, value, err = candidate, v, candidateErr
+				shrinks++
+				shrunk = true
+				break
+			}
+		}
+
+		if !shrunk {
+			break
+		}
+	}
+
+	return value, err, shrinks
+}
+
+func checkProperty[T any](property func(T) error, value T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return property(value)
+}
+
+// formatValue formats v like %#v, except pointers are followed, so the
+// counterexample shows the values pointed to instead of their addresses.
+func formatValue(v reflect.Value) string {
+	var b strings.Builder
+	writeValue(&b, v, make(map[uintptr]bool))
+	return b.String()
+}
+
+func writeValue(b *strings.Builder, v reflect.Value, visiting map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		b.WriteString("nil")
+	case reflect.Interface:
+		if v.IsNil() {
+			b.WriteString("nil")
+			return
+		}
+		writeValue(b, v.Elem(), visiting)
+	case reflect.Pointer:
+		if v.IsNil() {
+			_, _ = fmt.Fprintf(b, "(%s)(nil)", v.Type())
+			return
+		}
+
+		if visiting[v.Pointer()] {
+			_, _ = fmt.Fprintf(b, "(%s)(cycle)", v.Type())
+			return
+		}
+		visiting[v.Pointer()] = true
+		defer delete(visiting, v.Pointer())
+
+		b.WriteString("&")
+		writeValue(b, v.Elem(), visiting)
+	case reflect.Struct:
+		b.WriteString(v.Type().String())
+		b.WriteString("{")
+		for i := 0; i < v.NumField(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(v.Type().Field(i).Name)
+			b.WriteString(":")
+			writeValue(b, v.Field(i), visiting)
+		}
+		b.WriteString("}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			_, _ = fmt.Fprintf(b, "%#v", v)
+			return
+		}
+
+		b.WriteString(v.Type().String())
+		b.WriteString("{")
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeValue(b, v.Index(i), visiting)
+		}
+		b.WriteString("}")
+	case reflect.Map:
+		if v.IsNil() {
+			_, _ = fmt.Fprintf(b, "%#v", v)
+			return
+		}
+
+		var entries []string
+		for iter := v.MapRange(); iter.Next(); {
+			var entry strings.Builder
+			writeValue(&entry, iter.Key(), visiting)
+			entry.WriteString(":")
+			writeValue(&entry, iter.Value(), visiting)
+			entries = append(entries, entry.String())
+		}
+		sort.Strings(entries)
+
+		b.WriteString(v.Type().String())
+		b.WriteString("{")
+		b.WriteString(strings.Join(entries, ", "))
+		b.WriteString("}")
+	default:
+		_, _ = fmt.Fprintf(b, "%#v", v)
+	}
+}
@@ -0,0 +1,159 @@
+package testdata_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/kyuff/testdata"
+	"github.com/kyuff/testdata/internal/assert"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passing property", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		var (
+			cfg   = testdata.NewConfig()
+			fake  = &fakeT{name: t.Name()}
+			count = 0
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(values []int) error {
+			count++
+			return nil
+		}, testdata.CheckCases(25))
+
+		// assert
+		assert.Equal(t, 25, count)
+		assert.Equal(t, 0, len(fake.errors))
+	})
+
+	t.Run("shrink slice", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		var (
+			cfg  = testdata.NewConfig()
+			fake = &fakeT{name: t.Name()}
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(values []int) error {
+			if len(values) >= 3 {
+				return errors.New("too long")
+			}
+			return nil
+		})
+
+		// assert
+		if assert.Equal(t, 1, len(fake.errors)) {
+			assert.True(t, strings.Contains(fake.errors[0], "value: []int{0, 0, 0}"))
+			assert.True(t, strings.Contains(fake.errors[0], "error: too long"))
+		}
+	})
+
+	t.Run("shrink number", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		var (
+			cfg  = testdata.NewConfig()
+			fake = &fakeT{name: t.Name()}
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(value uint32) error {
+			if value > 1000 {
+				return fmt.Errorf("%d is too large", value)
+			}
+			return nil
+		})
+
+		// assert
+		if assert.Equal(t, 1, len(fake.errors)) {
+			assert.True(t, strings.Contains(fake.errors[0], "value: 0x3e9"))
+			assert.True(t, strings.Contains(fake.errors[0], "error: 1001 is too large"))
+		}
+	})
+
+	t.Run("shrink struct", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type Name string
+		type Person struct {
+			Name  Name
+			Age   int
+			Tags  []string
+			Notes map[string]string
+		}
+		var (
+			cfg  = testdata.NewConfig()
+			fake = &fakeT{name: t.Name()}
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(person Person) error {
+			if len(person.Name) > 0 {
+				return errors.New("has a name")
+			}
+			return nil
+		})
+
+		// assert
+		if assert.Equal(t, 1, len(fake.errors)) {
+			assert.Match(t, `Name:"[a-zA-Z0-9-]", Age:0, Tags:\[\]string\(nil\), Notes:map\[string\]string\(nil\)`, fake.errors[0])
+		}
+	})
+
+	t.Run("shrink pointers", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type Address struct {
+			City string
+		}
+		type Person struct {
+			Address *Address
+		}
+		var (
+			cfg  = testdata.NewConfig()
+			fake = &fakeT{name: t.Name()}
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(person Person) error {
+			if person.Address != nil {
+				return errors.New("has an address")
+			}
+			return nil
+		})
+
+		// assert
+		if assert.Equal(t, 1, len(fake.errors)) {
+			assert.Match(t, `value: testdata_test.Person\{Address:&testdata_test.Address\{City:".*"\}\}`, fake.errors[0])
+		}
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		var (
+			cfg  = testdata.NewConfig()
+			fake = &fakeT{name: t.Name()}
+		)
+
+		// act
+		testdata.CheckWith(fake, cfg, func(values []string) error {
+			_ = values[5]
+			return nil
+		})
+
+		// assert
+		if assert.Equal(t, 1, len(fake.errors)) {
+			assert.True(t, strings.Contains(fake.errors[0], "value: []string{}"))
+			assert.True(t, strings.Contains(fake.errors[0], "error: panic: runtime error: index out of range"))
+		}
+	})
+}
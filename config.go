@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/kyuff/testdata/internal/generate"
+	"github.com/kyuff/testdata/internal/seed"
 	"github.com/kyuff/testdata/internal/sticky"
 )
 
@@ -22,9 +23,23 @@ func init() {
 // Configure it using one ore more Option that is prefixed using With*.
 func NewConfig(opts ...Option) *Config {
 	cfg := &Config{
-		rules:  make(map[reflect.Type]func(r *rand.Rand) reflect.Value),
-		sticky: sticky.New(),
-		rand:   rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64())),
+		rules:           make(map[reflect.Type]func(g *Gen) reflect.Value),
+		domains:         make(map[reflect.Type]int),
+		implementations: make(map[reflect.Type][]reflect.Type),
+		lengths:         make(map[reflect.Type]lengthRange),
+		kindLengths:     make(map[reflect.Kind]lengthRange),
+		sticky:          sticky.New(),
+		seeds:           seed.Random(),
+		maxDepth:        3,
+		locale:          "en_US",
+		charsets:        make(map[reflect.Type]Charset),
+		prefix:          defaultPrefix,
+		prefixes:        make(map[reflect.Type]string),
+		clock:           time.Now,
+		timeFuture:      defaultTimeFuture,
+		timeLocations:   []*time.Location{time.UTC},
+		durationMax:     defaultDurationMax,
+		durationUnit:    time.Millisecond,
 	}
 	for _, opt := range opts {
 		opt(cfg)
@@ -35,20 +50,82 @@ func NewConfig(opts ...Option) *Config {
 
 // Config for testdata. Use either the DefaultConfig or create one with NewConfig.
 type Config struct {
-	rules  map[reflect.Type]func(r *rand.Rand) reflect.Value
-	sticky *sticky.Manager
-	rand   *rand.Rand
+	rules           map[reflect.Type]func(g *Gen) reflect.Value
+	fieldRules      []fieldRule
+	stickyKeys      []stickyKey
+	domains         map[reflect.Type]int
+	implementations map[reflect.Type][]reflect.Type
+	lengths         map[reflect.Type]lengthRange
+	kindLengths     map[reflect.Kind]lengthRange
+	nilProbability  float64
+	sticky          *sticky.Manager
+	seeds           *seed.Manager
+	rand            *rand.Rand
+	maxDepth        int
+	jsonDepth       int
+	jsonBreadth     int
+	strict          bool
+	locale          string
+	charset         Charset
+	charsets        map[reflect.Type]Charset
+	prefix          string
+	prefixes        map[reflect.Type]string
+	fullRange       bool
+	edgeCases       float64
+	clock           func() time.Time
+	clockSet        bool
+	timePast        time.Duration
+	timeFuture      time.Duration
+	timePrecision   time.Duration
+	timeLocations   []*time.Location
+	durationMin     time.Duration
+	durationMax     time.Duration
+	durationUnit    time.Duration
 }
 
-func (cfg *Config) make(t testingT, typ reflect.Type) reflect.Value {
-	stickyValue, isSticky := cfg.sticky.HasValue(t, typ)
+// randFor returns the *rand.Rand used to generate values for t.
+// Unless a *rand.Rand is set using WithRand, each test gets its own
+// stream derived from the seed of the Config and the name of the test.
+func (cfg *Config) randFor(t testingT) *rand.Rand {
+	if cfg.rand != nil {
+		return cfg.rand
+	}
+
+	return cfg.seeds.Rand(t)
+}
+
+// stickyValue returns the sticky value of typ. The root value of MakeStickyAs
+// only uses the value with its key made in the test itself.
+func (cfg *Config) stickyValue(g *Gen, typ reflect.Type) (reflect.Value, bool) {
+	if g.shadow {
+		g.shadow = false
+		return cfg.sticky.HasOwnValue(g.t, typ, g.key)
+	}
+
+	return cfg.sticky.HasValue(g.t, typ, "")
+}
+
+func (cfg *Config) make(g *Gen, typ reflect.Type) reflect.Value {
+	var stickyValue, isSticky = cfg.stickyValue(g, typ)
+
 	if isSticky {
 		return stickyValue
 	}
 
 	rule, ok := cfg.rules[typ]
-	if ok {
-		return rule(cfg.rand)
+	if ok && !g.inRule[typ] {
+		g.inRule[typ] = true
+		defer delete(g.inRule, typ)
+		return rule(g)
+	}
+
+	if cfg.tooDeep(g, typ) || cfg.makeNil(g, typ) {
+		return reflect.Zero(typ)
+	}
+	g.nested = true
+
+	if typ == locationType {
+		return generate.Location(g.rand)
 	}
 
 	var pointer = typ.Kind() == reflect.Pointer
@@ -56,7 +133,7 @@ func (cfg *Config) make(t testingT, typ reflect.Type) reflect.Value {
 		typ = typ.Elem()
 	}
 
-	var v = cfg.generateBuiltIn(t, typ)
+	var v = cfg.generateBuiltIn(g, typ)
 	if pointer {
 		return generate.Pointer(v)
 	}
@@ -64,51 +141,166 @@ func (cfg *Config) make(t testingT, typ reflect.Type) reflect.Value {
 	return v
 }
 
-var timeType = reflect.TypeOf(time.Time{})
-
-func (cfg *Config) generateBuiltIn(t testingT, typ reflect.Type) reflect.Value {
-	if timeType.ConvertibleTo(typ) {
-		return generate.Time(cfg.rand, typ)
+func (cfg *Config) generateBuiltIn(g *Gen, typ reflect.Type) reflect.Value {
+	var r = g.rand
+	if typ.Kind() == reflect.Struct && timeType.ConvertibleTo(typ) {
+		return cfg.generateTime(g, typ)
 	}
+	if typ == durationType {
+		return generate.Duration(r, cfg.durationMin, cfg.durationMax, cfg.durationUnit)
+	}
+	if v, ok := cfg.generateNumber(g, typ); ok {
+		return v
+	}
+
 	var maker = func(typ reflect.Type) reflect.Value {
-		return cfg.make(t, typ)
+		return cfg.make(g, typ)
 	}
 	switch typ.Kind() {
 	case reflect.Struct:
-		return generate.Struct(typ, maker)
+		g.depth[typ]++
+		defer func() { g.depth[typ]-- }()
+		return generate.Struct(typ, func(field reflect.StructField) reflect.Value {
+			return cfg.makeField(g, typ, field)
+		})
 	case reflect.Slice:
-		return generate.Slice(typ, maker, 5)
+		size, _ := cfg.length(g, typ)
+		return generate.Slice(typ, maker, size)
 	case reflect.Map:
-		return generate.Map(typ, maker, 5)
+		size, configured := cfg.length(g, typ)
+		return cfg.generateMap(g, typ, size, configured)
+	case reflect.Array:
+		return generate.Array(typ, maker)
+	case reflect.Chan:
+		size, _ := cfg.length(g, typ)
+		return generate.Chan(typ, maker, size)
+	case reflect.Func:
+		return generate.Func(typ, maker)
+	case reflect.Interface:
+		return cfg.generateInterface(g, typ)
+	case reflect.Pointer:
+		cfg.unsupported(g, typ, "pointers to pointers are not supported")
+		return reflect.Zero(typ)
+	case reflect.UnsafePointer:
+		cfg.unsupported(g, typ, "unsafe pointers are not supported")
+		return reflect.Zero(typ)
 	case reflect.String:
-		return generate.String(cfg.rand, typ, 16)
+		return cfg.generateString(g, typ)
 	case reflect.Int:
-		return generate.Int(cfg.rand)
+		return generate.Int(r)
 	case reflect.Int8:
-		return generate.Int8(cfg.rand)
+		return generate.Int8(r)
 	case reflect.Int16:
-		return generate.Int16(cfg.rand)
+		return generate.Int16(r)
 	case reflect.Int32:
-		return generate.Int32(cfg.rand)
+		return generate.Int32(r)
 	case reflect.Int64:
-		return generate.Int64(cfg.rand)
+		return generate.Int64(r)
 	case reflect.Bool:
-		return generate.Bool(cfg.rand)
+		return generate.Bool(r)
 	case reflect.Uint:
-		return generate.Uint(cfg.rand)
+		return generate.Uint(r)
 	case reflect.Uint8:
-		return generate.Uint8(cfg.rand)
+		return generate.Uint8(r)
 	case reflect.Uint16:
-		return generate.Uint16(cfg.rand)
+		return generate.Uint16(r)
 	case reflect.Uint32:
-		return generate.Uint32(cfg.rand)
+		return generate.Uint32(r)
 	case reflect.Uint64:
-		return generate.Uint64(cfg.rand)
+		return generate.Uint64(r)
+	case reflect.Uintptr:
+		return generate.Uintptr(r)
 	case reflect.Float32:
-		return generate.Float32(cfg.rand)
+		return generate.Float32(r)
 	case reflect.Float64:
-		return generate.Float64(cfg.rand)
+		return generate.Float64(r)
+	case reflect.Complex64:
+		return generate.Complex64(r)
+	case reflect.Complex128:
+		return generate.Complex128(r)
 	default:
+		cfg.unsupported(g, typ, "unsupported kind")
+		return reflect.Zero(typ)
+	}
+}
+
+// unsupported fails the test in strict mode, when a value of typ cannot be generated.
+func (cfg *Config) unsupported(g *Gen, typ reflect.Type, reason string) {
+	if cfg.strict {
+		fatalf(g.t, "testdata: cannot generate %s of type %s: %s", g.Path(), typ, reason)
+	}
+}
+
+// tooDeep reports if generating a value of typ would exceed the max depth
+// of a recursive type, ie a struct with a field of type *Self, []Self, map[K]*Self,
+// chan Self or func() Self. It is only the case for types where the zero value terminates the recursion.
+func (cfg *Config) tooDeep(g *Gen, typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Chan, reflect.Array:
+		return cfg.atMaxDepth(g, typ.Elem()) || cfg.tooDeep(g, typ.Elem())
+	case reflect.Func:
+		for i := 0; i < typ.NumOut(); i++ {
+			if cfg.atMaxDepth(g, typ.Out(i)) || cfg.tooDeep(g, typ.Out(i)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// atMaxDepth reports if typ is a struct that is already generated max depth
+// times on the current path.
+func (cfg *Config) atMaxDepth(g *Gen, typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && g.depth[typ] >= cfg.maxDepth
+}
+
+// generateInterface picks one of the implementations registered for the
+// interface typ and generates it. Without implementations the value is nil,
+// unless it is an empty interface and JSON values are enabled with WithAnyJSON.
+func (cfg *Config) generateInterface(g *Gen, typ reflect.Type) reflect.Value {
+	var implementations = cfg.implementations[typ]
+	if len(implementations) == 0 && typ.NumMethod() == 0 && cfg.jsonDepth > 0 {
+		var iface = reflect.New(typ).Elem()
+		if v := generate.JSON(g.rand, cfg.jsonDepth, cfg.jsonBreadth); v.IsValid() {
+			iface.Set(v)
+		}
+		return iface
+	}
+
+	if len(implementations) == 0 {
+		cfg.unsupported(g, typ, "no implementations registered")
 		return reflect.Zero(typ)
 	}
+
+	var impl = implementations[g.rand.IntN(len(implementations))]
+	var elem = impl
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+
+	if cfg.atMaxDepth(g, elem) {
+		return reflect.Zero(typ)
+	}
+
+	// The interface itself was already given the chance to be nil,
+	// so the implementation must not be a nil pointer inside it.
+	var nested = g.nested
+	g.nested = false
+	defer func() { g.nested = nested }()
+	var (
+		v     = cfg.make(g, impl)
+		iface = reflect.New(typ).Elem()
+	)
+
+	if impl.Kind() == reflect.Pointer && v.IsNil() {
+		return reflect.Zero(typ)
+	}
+
+	if v.Type() != impl {
+		v = v.Convert(impl)
+	}
+
+	iface.Set(v)
+	return iface
 }
@@ -6,6 +6,10 @@
 //   - Sticky variables
 //   - Global generator modifications
 //   - Local generator modifications
+//   - Struct tag constraints
+//   - Property based testing with shrinking
+//   - Native Go fuzzing of typed values
+//   - Realistic fake data in the fake package
 //
 // The main entrypoint is the Make and MakeSticky functions. They are meant to be used in tests to generate
 // a variable of a given type. They will use the globally defined DefaultConfig for the generation. If need be,
@@ -14,4 +18,34 @@
 // All Make functions accepts a list of modification functions that can be used to modify the generated value.
 // This is convenient in a test, where there is a need to make it clear the input has a certain value
 // that affects the expected outcome of the test.
+//
+// Struct fields can declare the shape of their generated values using the testdata struct tag:
+//
+//	type Person struct {
+//		Age      int      `testdata:"min=18,max=65"`
+//		Initials string   `testdata:"len=2"`
+//		Role     string   `testdata:"oneof=admin|user"`
+//		Tags     []string `testdata:"min=1,max=3"`
+//		Manager  *Person  `testdata:"zero"`
+//		Active   bool     `testdata:"nonzero"`
+//		Code     string   `testdata:"pattern=^[A-Z]{3}-\\d{6}$"`
+//	}
+//
+// The supported constraints are:
+//   - min and max: the range of numbers, or the length of strings, slices and maps
+//   - len: the exact length of strings, slices and maps
+//   - oneof: a list of values separated by |
+//   - pattern: a regular expression the string must match. It must be the last constraint
+//   - zero: the field is set to the zero value
+//   - skip: the field is not generated
+//   - nonzero: the field is generated until it is not the zero value
+//
+// Strings with a length constraint are generated without the type name prefix.
+//
+// Generators added with WithGenFunc get a Gen, which gives access to the random source,
+// the path of the value and the rest of the Config through MakeFrom.
+//
+// Each test gets its own random stream derived from a master seed and the name of the test.
+// When a test fails, the master seed is logged, and the run can be replayed by setting the
+// TESTDATA_SEED environment variable, or the -testdata.seed flag for a single package.
 package testdata
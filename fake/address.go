@@ -0,0 +1,20 @@
+package fake
+
+import (
+	"math/rand/v2"
+)
+
+// Street returns a street address line, ie "12 Main Street".
+func Street[T ~string](r *rand.Rand) T {
+	return T(enUS.Street(r))
+}
+
+// City returns the name of a city, ie "Chicago".
+func City[T ~string](r *rand.Rand) T {
+	return T(enUS.City(r))
+}
+
+// Postcode returns a postal code, ie "90210".
+func Postcode[T ~string](r *rand.Rand) T {
+	return T(enUS.Postcode(r))
+}
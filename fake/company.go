@@ -0,0 +1,10 @@
+package fake
+
+import (
+	"math/rand/v2"
+)
+
+// Company returns the name of a company, ie "Smith Holdings".
+func Company[T ~string](r *rand.Rand) T {
+	return T(enUS.Company(r))
+}
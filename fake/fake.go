@@ -0,0 +1,111 @@
+// Package fake provides generators of realistic looking values, like names, emails and addresses.
+//
+// All generators have the signature of a testdata generator, so they can be used directly:
+//
+//	cfg := testdata.NewConfig(
+//		testdata.WithGenerator(fake.Email[Email]),
+//	)
+//
+// Or for all struct fields with well known names, like Email, Phone and City:
+//
+//	cfg := testdata.NewConfig(fake.Fields())
+//
+// The generators use the en_US locale. Values of other locales are generated by the
+// methods of a Locale, and by Fields and Localized, which use the locale set with testdata.WithLocale.
+// The supported locales are en_US, da_DK, de_DE and ja_JP.
+//
+// The values are picked from word lists embedded in the package, so no network access is needed.
+package fake
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"io/fs"
+	"math/rand/v2"
+	"path"
+	"strings"
+)
+
+//go:embed data
+var data embed.FS
+
+var (
+	domains    = words("common/domains.txt")
+	userAgents = words("common/user_agents.txt")
+	lorem      = words("common/lorem.txt")
+)
+
+// words reads the non-empty lines of an embedded word list.
+func words(name string) []string {
+	list, err := readWords(name)
+	if err != nil {
+		panic("fake: " + err.Error())
+	}
+
+	return list
+}
+
+func readWords(name string) ([]string, error) {
+	f, err := data.Open(path.Join("data", name))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		list    []string
+		scanner = bufio.NewScanner(f)
+	)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			list = append(list, line)
+		}
+	}
+
+	return list, scanner.Err()
+}
+
+// latinWords reads the latin spelling of a word list, ie for use in emails.
+// When the locale has no such list, the words are transliterated.
+func latinWords(name string, fallback []string) []string {
+	list, err := readWords(name)
+	if err == nil {
+		return list
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		panic("fake: " + err.Error())
+	}
+
+	list = make([]string, len(fallback))
+	for i, word := range fallback {
+		list[i] = transliterate(word)
+	}
+
+	return list
+}
+
+var transliterate = strings.NewReplacer(
+	"æ", "ae", "ø", "oe", "å", "aa", "Æ", "Ae", "Ø", "Oe", "Å", "Aa",
+	"ä", "ae", "ö", "oe", "ü", "ue", "Ä", "Ae", "Ö", "Oe", "Ü", "Ue", "ß", "ss",
+	"é", "e", "è", "e",
+).Replace
+
+func pick(r *rand.Rand, list []string) string {
+	return list[r.IntN(len(list))]
+}
+
+// digits replaces each # in format with a random digit.
+func digits(r *rand.Rand, format string) string {
+	var b strings.Builder
+	for _, c := range format {
+		if c == '#' {
+			b.WriteByte(byte('0' + r.IntN(10)))
+		} else {
+			b.WriteRune(c)
+		}
+	}
+
+	return b.String()
+}
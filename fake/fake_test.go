@@ -0,0 +1,263 @@
+package fake_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"net/netip"
+	"testing"
+
+	"github.com/kyuff/testdata"
+	"github.com/kyuff/testdata/fake"
+	"github.com/kyuff/testdata/internal/assert"
+)
+
+func TestFake(t *testing.T) {
+	t.Parallel()
+	type Text string
+
+	var testCases = []struct {
+		name      string
+		generator func(r *rand.Rand) Text
+		expected  string
+	}{
+		{"FirstName", fake.FirstName[Text], `^[A-Z][a-z]+$`},
+		{"LastName", fake.LastName[Text], `^[A-Z][a-z]+$`},
+		{"Name", fake.Name[Text], `^[A-Z][a-z]+ [A-Z][a-z]+$`},
+		{"Username", fake.Username[Text], `^[a-z]+\.[a-z]+\d{1,2}$`},
+		{"Email", fake.Email[Text], `^[a-z]+\.[a-z]+\d{1,2}@example\.(com|org|net)$`},
+		{"Phone", fake.Phone[Text], `^\(\d{3}\) \d{3}-\d{4}$`},
+		{"Street", fake.Street[Text], `^\d{1,3} [A-Z][a-z]+( [A-Z][a-z]+)+$`},
+		{"City", fake.City[Text], `^[A-Z][a-z]+( [A-Z][a-z]+)?$`},
+		{"Postcode", fake.Postcode[Text], `^\d{5}$`},
+		{"Company", fake.Company[Text], `^[A-Z][a-z]+ [A-Z][A-Za-z]+$`},
+		{"URL", fake.URL[Text], `^https://www\.[a-z]+\.example\.(com|org|net)/$`},
+		{"Date", fake.Date[Text], `^(0[1-9]|1[0-2])/(0[1-9]|[12]\d|3[01])/(19[5-9]\d|20[0-4]\d)$`},
+		{"UserAgent", fake.UserAgent[Text], `^(Mozilla|curl)/`},
+		{"Word", fake.Word[Text], `^[a-z]+$`},
+		{"Sentence", fake.Sentence[Text], `^[A-Z][a-z]*( [a-z]+){3,11}\.$`},
+		{"Paragraph", fake.Paragraph[Text], `^([A-Z][a-z]*( [a-z]+){3,11}\.)( [A-Z][a-z]*( [a-z]+){3,11}\.){2,5}$`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var r = rand.New(rand.NewPCG(1, 2))
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := tc.generator(r)
+
+				// assert
+				assert.Match(t, tc.expected, got)
+			}
+		})
+	}
+
+	t.Run("IP", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		var r = rand.New(rand.NewPCG(1, 2))
+
+		// act
+		v4, err4 := netip.ParseAddr(fake.IPv4[string](r))
+		v6, err6 := netip.ParseAddr(fake.IPv6[string](r))
+
+		// assert
+		if assert.NoError(t, err4) {
+			assert.True(t, v4.Is4())
+		}
+		if assert.NoError(t, err6) {
+			assert.True(t, v6.Is6())
+		}
+	})
+
+	t.Run("generator", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type Email string
+		var cfg = testdata.NewConfig(testdata.WithGenerator(fake.Email[Email]))
+
+		// act
+		got := testdata.MakeWith[Email](t, cfg)
+
+		// assert
+		assert.Match(t, `^[a-z]+\.[a-z]+\d{1,2}@example\.(com|org|net)$`, got)
+	})
+
+	t.Run("locales", func(t *testing.T) {
+		t.Parallel()
+		var testCases = []struct {
+			locale string
+			name   string
+			street string
+			phone  string
+			date   string
+		}{
+			{"en_US", `^[A-Z][a-z]+ [A-Z][a-z]+$`, `^\d{1,2} [A-Z]`, `^\(\d{3}\) \d{3}-\d{4}$`, `^\d{2}/\d{2}/\d{4}$`},
+			{"da_DK", `^\p{Lu}\p{Ll}+ \p{Lu}\p{Ll}+$`, `^\p{Lu}.* \d{1,2}$`, `^\+45 \d{2} \d{2} \d{2} \d{2}$`, `^\d{2}-\d{2}-\d{4}$`},
+			{"de_DE", `^\p{Lu}\p{Ll}+ \p{Lu}\p{Ll}+$`, `^\p{Lu}.* \d{1,2}$`, `^\+49 \d{3} \d{7}$`, `^\d{2}\.\d{2}\.\d{4}$`},
+			{"ja_JP", `^\p{Han}+ [\p{Han}\p{Hiragana}]+$`, `^\p{Han}+[\p{Han}\p{Hiragana}]*\d{1,2}丁目$`, `^0\d0-\d{4}-\d{4}$`, `^\d{4}年\d{2}月\d{2}日$`},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.locale, func(t *testing.T) {
+				t.Parallel()
+				// arrange
+				type Person struct {
+					Name    string
+					Email   string
+					Street  string
+					Phone   string
+					Company string
+				}
+				var (
+					cfg    = testdata.NewConfig(testdata.WithLocale(tc.locale), fake.Fields())
+					locale = fake.LocaleOf(tc.locale)
+					r      = rand.New(rand.NewPCG(1, 2))
+				)
+
+				// act
+				got := testdata.MakeWith[Person](t, cfg)
+
+				// assert
+				assert.Match(t, tc.name, got.Name)
+				assert.Match(t, `^[a-z]+\.[a-z]+\d{1,2}@example\.(com|org|net)$`, got.Email)
+				assert.Match(t, tc.street, got.Street)
+				assert.Match(t, tc.phone, got.Phone)
+				assert.Match(t, tc.date, locale.Date(r))
+				assert.Equal(t, tc.locale, locale.Name())
+			})
+		}
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type City string
+		var cfg = testdata.NewConfig(
+			testdata.WithLocale("de_DE"),
+			testdata.WithGenFunc(fake.Localized[City]((*fake.Locale).City)),
+		)
+
+		// act
+		got := testdata.MakeWith[City](t, cfg)
+
+		// assert
+		assert.OneOf(t, []City{"Berlin", "Hamburg", "München", "Köln", "Frankfurt am Main", "Stuttgart", "Düsseldorf", "Leipzig",
+			"Dortmund", "Essen", "Bremen", "Dresden", "Hannover", "Nürnberg", "Duisburg", "Bochum", "Wuppertal", "Bielefeld",
+			"Bonn", "Münster", "Mannheim", "Karlsruhe", "Augsburg", "Wiesbaden", "Mönchengladbach", "Gelsenkirchen", "Aachen",
+			"Braunschweig", "Kiel", "Lübeck"}, got)
+	})
+
+	t.Run("unsupported locale", func(t *testing.T) {
+		t.Parallel()
+		// act
+		got := assert.Panic(t, func() { fake.LocaleOf("xx_XX") })
+
+		// assert
+		assert.Equal(t, `fake: unsupported locale "xx_XX", use one of da_DK, de_DE, en_US, ja_JP`, got)
+	})
+
+	t.Run("unsupported locale in generator", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type City string
+		var (
+			cfg = testdata.NewConfig(
+				testdata.WithLocale("fr_FR"),
+				testdata.WithGenFunc(fake.Localized[City]((*fake.Locale).City)),
+			)
+			fakeT = &reportingT{name: t.Name()}
+		)
+
+		// act
+		got := testdata.MakeWith[[]City](fakeT, cfg)
+		other := testdata.MakeWith[City](fakeT, cfg)
+
+		// assert
+		assert.NoneZero(t, got)
+		assert.NotZero(t, other)
+		if assert.Equal(t, 1, len(fakeT.errors)) {
+			assert.Equal(t, `fake: unsupported locale "fr_FR", use one of da_DK, de_DE, en_US, ja_JP`, fakeT.errors[0])
+		}
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type Address struct {
+			Street  string
+			City    string
+			ZipCode string
+			Country string
+		}
+		type Customer struct {
+			Name    string
+			Email   string
+			Phone   *string
+			Address Address
+		}
+		var cfg = testdata.NewConfig(
+			fake.Fields(),
+			testdata.WithFieldGenerator("Country", func(r *rand.Rand) string {
+				return "US"
+			}),
+			testdata.WithFieldGenerator("City", func(r *rand.Rand) string {
+				return "Springfield"
+			}),
+		)
+
+		// act
+		got := testdata.MakeWith[Customer](t, cfg)
+
+		// assert
+		assert.Match(t, `^[A-Z][a-z]+ [A-Z][a-z]+$`, got.Name)
+		assert.Match(t, `@example\.(com|org|net)$`, got.Email)
+		if assert.NotNil(t, got.Phone) {
+			assert.Match(t, `^\(\d{3}\) \d{3}-\d{4}$`, *got.Phone)
+		}
+		assert.Match(t, `^\d{1,3} `, got.Address.Street)
+		assert.Equal(t, "Springfield", got.Address.City)
+		assert.Match(t, `^\d{5}$`, got.Address.ZipCode)
+		assert.Equal(t, "US", got.Address.Country)
+	})
+
+	t.Run("fields of other kinds", func(t *testing.T) {
+		t.Parallel()
+		// arrange
+		type Company struct {
+			Name string
+		}
+		type Employee struct {
+			Name    string
+			Company *Company
+		}
+		var cfg = testdata.NewConfig(fake.Fields())
+
+		// act
+		got := testdata.MakeWith[Employee](t, cfg)
+
+		// assert
+		assert.Match(t, `^[A-Z][a-z]+ [A-Z][a-z]+$`, got.Name)
+		if assert.NotNil(t, got.Company) {
+			assert.NotZero(t, got.Company.Name)
+		}
+	})
+}
+
+// reportingT records the errors reported by the generators.
+type reportingT struct {
+	name   string
+	errors []string
+}
+
+func (t *reportingT) Name() string {
+	return t.name
+}
+
+func (t *reportingT) Cleanup(fn func()) {}
+
+func (t *reportingT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
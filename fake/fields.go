@@ -0,0 +1,58 @@
+package fake
+
+import (
+	"math/rand/v2"
+
+	"github.com/kyuff/testdata"
+)
+
+// fields maps the names of struct fields to the generator used for them by Fields.
+var fields = []struct {
+	names     []string
+	generator func(l *Locale, r *rand.Rand) string
+}{
+	{[]string{"FirstName", "GivenName"}, (*Locale).FirstName},
+	{[]string{"LastName", "Surname", "FamilyName"}, (*Locale).LastName},
+	{[]string{"Name", "FullName"}, (*Locale).FullName},
+	{[]string{"Username", "UserName", "Login"}, (*Locale).Username},
+	{[]string{"Email", "EmailAddress"}, (*Locale).Email},
+	{[]string{"Phone", "PhoneNumber", "Mobile"}, (*Locale).Phone},
+	{[]string{"Street", "StreetAddress", "AddressLine"}, (*Locale).Street},
+	{[]string{"City"}, (*Locale).City},
+	{[]string{"Postcode", "PostalCode", "ZipCode", "Zip"}, (*Locale).Postcode},
+	{[]string{"Company", "CompanyName"}, (*Locale).Company},
+	{[]string{"URL", "Website", "Homepage"}, (*Locale).URL},
+	{[]string{"IP", "IPAddress", "IPv4"}, anyLocale(IPv4[string])},
+	{[]string{"IPv6"}, anyLocale(IPv6[string])},
+	{[]string{"UserAgent"}, anyLocale(UserAgent[string])},
+	{[]string{"Title", "Summary"}, anyLocale(Sentence[string])},
+	{[]string{"Description", "Bio"}, anyLocale(Paragraph[string])},
+}
+
+// Fields returns an Option using the generators of the package for struct fields
+// with well known names, ie Email, Phone, City and Description. The values are
+// generated in the locale set with testdata.WithLocale.
+//
+// Only fields of a string type or a pointer to one are affected, so ie a field
+// Company *Company is generated as usual. Generators added to the Config after
+// Fields take precedence for the same field name.
+func Fields() testdata.Option {
+	var opts []testdata.Option
+	for _, field := range fields {
+		for _, name := range field.names {
+			opts = append(opts, testdata.WithFieldGenFunc(name, Localized[string](field.generator)))
+		}
+	}
+
+	return func(cfg *testdata.Config) {
+		for _, opt := range opts {
+			opt(cfg)
+		}
+	}
+}
+
+func anyLocale(generator func(r *rand.Rand) string) func(l *Locale, r *rand.Rand) string {
+	return func(_ *Locale, r *rand.Rand) string {
+		return generator(r)
+	}
+}
@@ -0,0 +1,36 @@
+package fake
+
+import (
+	"math/rand/v2"
+	"net/netip"
+)
+
+// URL returns an url on one of the domains reserved for examples, ie "https://www.smith.example.com/".
+func URL[T ~string](r *rand.Rand) T {
+	return T(enUS.URL(r))
+}
+
+// IPv4 returns an IPv4 address, ie "192.0.2.1".
+func IPv4[T ~string](r *rand.Rand) T {
+	var b [4]byte
+	for i := range b {
+		b[i] = byte(r.IntN(256))
+	}
+
+	return T(netip.AddrFrom4(b).String())
+}
+
+// IPv6 returns an IPv6 address, ie "2001:db8::1".
+func IPv6[T ~string](r *rand.Rand) T {
+	var b [16]byte
+	for i := range b {
+		b[i] = byte(r.IntN(256))
+	}
+
+	return T(netip.AddrFrom16(b).String())
+}
+
+// UserAgent returns the User-Agent header of a common browser or client.
+func UserAgent[T ~string](r *rand.Rand) T {
+	return T(pick(r, userAgents))
+}
@@ -0,0 +1,177 @@
+package fake
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyuff/testdata"
+)
+
+// Locale is the dataset used to generate values of a language and region.
+type Locale struct {
+	name            string
+	firstNames      []string
+	lastNames       []string
+	latinFirstNames []string
+	latinLastNames  []string
+	streets         []string
+	cities          []string
+	companySuffixes []string
+	format          localeFormat
+}
+
+type localeFormat struct {
+	// name formats a first and last name.
+	name string
+	// street formats a street number and name into an address line.
+	street string
+	// company formats a last name and a company suffix.
+	company string
+	// postcode and phone are formats where # is replaced by a digit.
+	postcode string
+	phone    string
+	// date is the layout of a date, as used by time.Format.
+	date string
+}
+
+var (
+	enUS    = newLocale("en_US", localeFormat{name: "%[1]s %[2]s", street: "%[1]d %[2]s", company: "%[1]s %[2]s", postcode: "#####", phone: "(###) ###-####", date: "01/02/2006"})
+	locales = map[string]*Locale{
+		"en_US": enUS,
+		"da_DK": newLocale("da_DK", localeFormat{name: "%[1]s %[2]s", street: "%[2]s %[1]d", company: "%[1]s %[2]s", postcode: "####", phone: "+45 ## ## ## ##", date: "02-01-2006"}),
+		"de_DE": newLocale("de_DE", localeFormat{name: "%[1]s %[2]s", street: "%[2]s %[1]d", company: "%[1]s %[2]s", postcode: "#####", phone: "+49 ### #######", date: "02.01.2006"}),
+		"ja_JP": newLocale("ja_JP", localeFormat{name: "%[2]s %[1]s", street: "%[2]s%[1]d丁目", company: "%[1]s%[2]s", postcode: "###-####", phone: "0#0-####-####", date: "2006年01月02日"}),
+	}
+)
+
+func newLocale(name string, format localeFormat) *Locale {
+	var l = &Locale{
+		name:            name,
+		firstNames:      words(path.Join(name, "first_names.txt")),
+		lastNames:       words(path.Join(name, "last_names.txt")),
+		streets:         words(path.Join(name, "streets.txt")),
+		cities:          words(path.Join(name, "cities.txt")),
+		companySuffixes: words(path.Join(name, "company_suffixes.txt")),
+		format:          format,
+	}
+	l.latinFirstNames = latinWords(path.Join(name, "first_names_latin.txt"), l.firstNames)
+	l.latinLastNames = latinWords(path.Join(name, "last_names_latin.txt"), l.lastNames)
+
+	return l
+}
+
+// LocaleOf returns the Locale with the name, ie "da_DK". It panics if the locale is not supported.
+func LocaleOf(name string) *Locale {
+	l, ok := locales[name]
+	if !ok {
+		panic(fmt.Sprintf("fake: unsupported locale %q, use one of %s", name, strings.Join(Locales(), ", ")))
+	}
+
+	return l
+}
+
+// Locales returns the names of the supported locales.
+func Locales() []string {
+	var names = make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Localized returns a generator using the method of the Locale set with testdata.WithLocale.
+// If the locale is not supported, en_US is used instead, and the error is reported once per test.
+//
+//	testdata.WithGenFunc(fake.Localized[Email]((*fake.Locale).Email))
+func Localized[T ~string](method func(l *Locale, r *rand.Rand) string) func(g *testdata.Gen) T {
+	return func(g *testdata.Gen) T {
+		l, ok := locales[g.Locale()]
+		if !ok {
+			g.Errorf("fake: unsupported locale %q, use one of %s", g.Locale(), strings.Join(Locales(), ", "))
+			l = enUS
+		}
+
+		return T(method(l, g.Rand()))
+	}
+}
+
+// Name of the locale, ie "da_DK".
+func (l *Locale) Name() string {
+	return l.name
+}
+
+// DateLayout returns the layout of a date in the locale, as used by time.Format.
+func (l *Locale) DateLayout() string {
+	return l.format.date
+}
+
+// FirstName returns a first name.
+func (l *Locale) FirstName(r *rand.Rand) string {
+	return pick(r, l.firstNames)
+}
+
+// LastName returns a last name.
+func (l *Locale) LastName(r *rand.Rand) string {
+	return pick(r, l.lastNames)
+}
+
+// FullName returns a first and last name, in the order of the locale.
+func (l *Locale) FullName(r *rand.Rand) string {
+	return fmt.Sprintf(l.format.name, pick(r, l.firstNames), pick(r, l.lastNames))
+}
+
+// Username returns a lower case username in latin letters.
+func (l *Locale) Username(r *rand.Rand) string {
+	return fmt.Sprintf("%s.%s%d",
+		strings.ToLower(pick(r, l.latinFirstNames)),
+		strings.ToLower(pick(r, l.latinLastNames)),
+		r.IntN(100),
+	)
+}
+
+// Email returns an email address on one of the domains reserved for examples.
+func (l *Locale) Email(r *rand.Rand) string {
+	return l.Username(r) + "@" + pick(r, domains)
+}
+
+// Phone returns a phone number.
+func (l *Locale) Phone(r *rand.Rand) string {
+	return digits(r, l.format.phone)
+}
+
+// Street returns a street address line.
+func (l *Locale) Street(r *rand.Rand) string {
+	return fmt.Sprintf(l.format.street, 1+r.IntN(99), pick(r, l.streets))
+}
+
+// City returns the name of a city.
+func (l *Locale) City(r *rand.Rand) string {
+	return pick(r, l.cities)
+}
+
+// Postcode returns a postal code.
+func (l *Locale) Postcode(r *rand.Rand) string {
+	return digits(r, l.format.postcode)
+}
+
+// Company returns the name of a company.
+func (l *Locale) Company(r *rand.Rand) string {
+	return fmt.Sprintf(l.format.company, pick(r, l.lastNames), pick(r, l.companySuffixes))
+}
+
+// URL returns an url on one of the domains reserved for examples.
+func (l *Locale) URL(r *rand.Rand) string {
+	return "https://www." + strings.ToLower(pick(r, l.latinLastNames)) + "." + pick(r, domains) + "/"
+}
+
+// Date returns a date between 1950 and 2049 formatted with the layout of the locale.
+func (l *Locale) Date(r *rand.Rand) string {
+	var date = time.Date(1950+r.IntN(100), time.January, 1+r.IntN(365), 0, 0, 0, 0, time.UTC)
+	return date.Format(l.format.date)
+}
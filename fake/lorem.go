@@ -0,0 +1,36 @@
+package fake
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// Word returns a lorem ipsum word, ie "dolor".
+func Word[T ~string](r *rand.Rand) T {
+	return T(pick(r, lorem))
+}
+
+// Sentence returns a lorem ipsum sentence of 4 to 12 words.
+func Sentence[T ~string](r *rand.Rand) T {
+	return T(sentence(r))
+}
+
+// Paragraph returns a lorem ipsum paragraph of 3 to 6 sentences.
+func Paragraph[T ~string](r *rand.Rand) T {
+	var sentences = make([]string, 3+r.IntN(4))
+	for i := range sentences {
+		sentences[i] = sentence(r)
+	}
+
+	return T(strings.Join(sentences, " "))
+}
+
+func sentence(r *rand.Rand) string {
+	var words = make([]string, 4+r.IntN(9))
+	for i := range words {
+		words[i] = pick(r, lorem)
+	}
+
+	var s = strings.Join(words, " ")
+	return strings.ToUpper(s[:1]) + s[1:] + "."
+}
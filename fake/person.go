@@ -0,0 +1,40 @@
+package fake
+
+import (
+	"math/rand/v2"
+)
+
+// FirstName returns a first name, ie "Mary".
+func FirstName[T ~string](r *rand.Rand) T {
+	return T(enUS.FirstName(r))
+}
+
+// LastName returns a last name, ie "Smith".
+func LastName[T ~string](r *rand.Rand) T {
+	return T(enUS.LastName(r))
+}
+
+// Name returns a first and last name, ie "Mary Smith".
+func Name[T ~string](r *rand.Rand) T {
+	return T(enUS.FullName(r))
+}
+
+// Username returns a lower case username, ie "mary.smith42".
+func Username[T ~string](r *rand.Rand) T {
+	return T(enUS.Username(r))
+}
+
+// Email returns an email address on one of the domains reserved for examples, ie "mary.smith42@example.com".
+func Email[T ~string](r *rand.Rand) T {
+	return T(enUS.Email(r))
+}
+
+// Phone returns a phone number, ie "(555) 123-4567".
+func Phone[T ~string](r *rand.Rand) T {
+	return T(enUS.Phone(r))
+}
+
+// Date returns a date formatted as MM/DD/YYYY, ie "07/04/1976".
+func Date[T ~string](r *rand.Rand) T {
+	return T(enUS.Date(r))
+}
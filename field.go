@@ -0,0 +1,188 @@
+package testdata
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/kyuff/testdata/internal/generate"
+	"github.com/kyuff/testdata/internal/tag"
+)
+
+// fieldMatcher matches struct fields by a key, which is either
+//   - the name of the field, ie "Email"
+//   - a path ending in the field, ie "User.ID", where User is the name of a field or the struct type
+//   - the name of the field in a struct tag, ie "json:email"
+type fieldMatcher struct {
+	key      string
+	segments []string
+	tagKey   string
+	tagName  string
+}
+
+func newFieldMatcher(key string) fieldMatcher {
+	if key == "" {
+		panic("testdata: empty field key")
+	}
+
+	if tagKey, tagName, ok := strings.Cut(key, ":"); ok {
+		return fieldMatcher{key: key, tagKey: tagKey, tagName: tagName}
+	}
+
+	return fieldMatcher{key: key, segments: strings.Split(key, ".")}
+}
+
+// match returns how specific the match of the field is, or 0 if it does not match.
+// A longer path is more specific than a field name.
+func (m fieldMatcher) match(g *Gen, parent reflect.Type, field reflect.StructField) int {
+	if m.tagKey != "" {
+		name, _, _ := strings.Cut(field.Tag.Get(m.tagKey), ",")
+		if name == m.tagName {
+			return 1
+		}
+		return 0
+	}
+
+	if m.segments[len(m.segments)-1] != field.Name {
+		return 0
+	}
+
+	var path = g.Path()
+	if path == m.key || strings.HasSuffix(path, "."+m.key) {
+		return len(m.segments)
+	}
+
+	if len(m.segments) == 2 && m.segments[0] == parent.Name() {
+		return len(m.segments)
+	}
+
+	return 0
+}
+
+type fieldRule struct {
+	matcher  fieldMatcher
+	generate func(g *Gen) reflect.Value
+	// typ is the type returned by generate. The rule only matches
+	// fields the type can be used for.
+	typ reflect.Type
+}
+
+// accepts reports if the generated type can be used for the field. An interface
+// type is checked when the value is generated, as it depends on the dynamic type.
+func (rule fieldRule) accepts(field reflect.StructField) bool {
+	return rule.typ.Kind() == reflect.Interface || fieldValue(reflect.Zero(rule.typ), field.Type).IsValid()
+}
+
+// fieldRule returns the most specific rule matching the field.
+// When rules are equally specific, the last one added is used.
+func (cfg *Config) fieldRule(g *Gen, parent reflect.Type, field reflect.StructField) (fieldRule, bool) {
+	var (
+		best      fieldRule
+		bestMatch = 0
+	)
+
+	for _, rule := range cfg.fieldRules {
+		if !rule.accepts(field) {
+			continue
+		}
+
+		if m := rule.matcher.match(g, parent, field); m > 0 && m >= bestMatch {
+			best, bestMatch = rule, m
+		}
+	}
+
+	return best, bestMatch > 0
+}
+
+type stickyKey struct {
+	matcher fieldMatcher
+	key     string
+}
+
+// stickyField returns the sticky value of the key bound to the field using WithStickyKey.
+// When fields are bound by equally specific matchers, the last one added is used.
+func (cfg *Config) stickyField(g *Gen, parent reflect.Type, field reflect.StructField) (reflect.Value, bool) {
+	var (
+		best      stickyKey
+		bestMatch = 0
+	)
+
+	for _, binding := range cfg.stickyKeys {
+		if m := binding.matcher.match(g, parent, field); m > 0 && m >= bestMatch {
+			best, bestMatch = binding, m
+		}
+	}
+
+	if bestMatch == 0 {
+		return reflect.Value{}, false
+	}
+
+	return cfg.sticky.HasValue(g.t, field.Type, best.key)
+}
+
+func (cfg *Config) makeField(g *Gen, parent reflect.Type, field reflect.StructField) reflect.Value {
+	g.pushField(parent, field.Name)
+	defer g.popField()
+
+	if v, found := cfg.override(g, field); found {
+		return v
+	}
+
+	fieldTag, ok, err := tag.Lookup(field)
+	if err != nil {
+		fatalf(g.t, "testdata: %s", err)
+		return reflect.Zero(field.Type)
+	}
+
+	if !fieldTag.Skip && !fieldTag.Zero {
+		if v, found := cfg.stickyField(g, parent, field); found {
+			return v
+		}
+
+		if rule, found := cfg.fieldRule(g, parent, field); found {
+			// A sticky value of the type wins over a field rule,
+			// the same way it does over a type rule.
+			if v, isSticky := cfg.stickyValue(g, field.Type); isSticky {
+				return v
+			}
+
+			return cfg.applyFieldRule(g, rule, field)
+		}
+	}
+
+	if !ok {
+		return cfg.make(g, field.Type)
+	}
+
+	return cfg.makeTagged(g, field, fieldTag)
+}
+
+// applyFieldRule generates the value of the field using the rule. The value is converted
+// to the type of the field, or a pointer to it.
+func (cfg *Config) applyFieldRule(g *Gen, rule fieldRule, field reflect.StructField) reflect.Value {
+	var v = rule.generate(g)
+	if !v.IsValid() {
+		return reflect.Zero(field.Type)
+	}
+
+	if fv := fieldValue(v, field.Type); fv.IsValid() {
+		return fv
+	}
+
+	fatalf(g.t, "testdata: field generator %q returns %s, which cannot be used for %s of type %s",
+		rule.matcher.key, v.Type(), g.Path(), field.Type)
+	return reflect.Zero(field.Type)
+}
+
+// fieldValue converts v to the type of a field, or returns an invalid value if it cannot be used for it.
+func fieldValue(v reflect.Value, typ reflect.Type) reflect.Value {
+	switch {
+	case v.Type().AssignableTo(typ):
+		return v
+	case v.Kind() == typ.Kind() && v.Type().ConvertibleTo(typ):
+		return v.Convert(typ)
+	case typ.Kind() == reflect.Pointer && v.Type().ConvertibleTo(typ.Elem()):
+		return generate.Pointer(v.Convert(typ.Elem()))
+	}
+
+	return reflect.Value{}
+}
@@ -0,0 +1,40 @@
+package testdata
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kyuff/testdata/internal/seed"
+)
+
+// Fuzz runs a native Go fuzz test with values T generated by DefaultConfig.
+// The input of the fuzzer is used as the entropy when generating the value,
+// so coverage guided fuzzing mutates T, and a corpus entry always replays the same value.
+// Unless a clock is set using WithClock, times are generated around a fixed epoch to keep them replayable.
+func Fuzz[T any](f *testing.F, fuzz func(t *testing.T, v T)) {
+	f.Helper()
+	FuzzWith[T](f, DefaultConfig, fuzz)
+}
+
+// FuzzWith is similar to Fuzz, just using cfg instead of DefaultConfig.
+func FuzzWith[T any](f *testing.F, cfg *Config, fuzz func(t *testing.T, v T)) {
+	f.Helper()
+	if !cfg.clockSet {
+		var pinned = *cfg
+		pinned.clock = fuzzClock
+		cfg = &pinned
+	}
+
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v = makeWith[T](newGen(cfg, t, rand.New(seed.Bytes(data)), reflect.TypeFor[T]()))
+		fuzz(t, v)
+	})
+}
+
+// fuzzClock is the clock used by FuzzWith, unless one is set using WithClock.
+func fuzzClock() time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+}
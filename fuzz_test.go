@@ -0,0 +1,43 @@
+package testdata_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyuff/testdata"
+	"github.com/kyuff/testdata/internal/assert"
+)
+
+func FuzzFuzz(f *testing.F) {
+	type Name string
+	type Person struct {
+		Name  Name
+		Age   int
+		Names []Name
+		Born  time.Time
+	}
+
+	var (
+		cfg    = testdata.NewConfig()
+		values []Person
+	)
+
+	f.Add([]byte("replay"))
+	f.Add([]byte("replay"))
+
+	f.Cleanup(func() {
+		// only the seed corpus is run when not fuzzing
+		if len(values) == 3 {
+			assert.Equal(f, values[0].Name, values[1].Name)
+			assert.Equal(f, values[0].Age, values[1].Age)
+			assert.Equal(f, values[0].Born, values[1].Born)
+			assert.NotEqual(f, values[0].Name, values[2].Name)
+		}
+	})
+
+	testdata.FuzzWith(f, cfg, func(t *testing.T, person Person) {
+		values = append(values, person)
+		assert.Match(t, "^Name-[a-zA-Z0-9]{16}$", person.Name)
+		assert.Equal(t, 5, len(person.Names))
+	})
+}
@@ -0,0 +1,147 @@
+package testdata
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Gen is the state of generating a single value. It is passed to generators
+// added with WithGenFunc, so they can use the rest of the Config, ie by calling MakeFrom.
+type Gen struct {
+	cfg  *Config
+	t    testingT
+	rand *rand.Rand
+	root reflect.Type
+	// path is the names of the struct fields from the root value
+	// to the value currently being generated.
+	path []string
+	// parents is the struct types of the fields in path.
+	parents []reflect.Type
+	// depth counts how many times a struct type is being generated
+	// on the current path from the root value.
+	depth map[reflect.Type]int
+	// inRule is the types currently generated by a rule. A rule
+	// calling MakeFrom for its own type gets the built-in value.
+	inRule map[reflect.Type]bool
+	// nested is set once the root value is being generated.
+	nested bool
+	// shadow makes the root value ignore sticky values of parent tests.
+	shadow bool
+	// key of the sticky root value, when made by MakeStickyAs.
+	key string
+	// overrides of fields in the root value, made by Set.
+	overrides []*override
+}
+
+func newGen(cfg *Config, t testingT, r *rand.Rand, root reflect.Type) *Gen {
+	return &Gen{
+		cfg:    cfg,
+		t:      t,
+		rand:   r,
+		root:   root,
+		depth:  make(map[reflect.Type]int),
+		inRule: make(map[reflect.Type]bool),
+	}
+}
+
+// Rand returns the *rand.Rand used to generate the value.
+func (g *Gen) Rand() *rand.Rand {
+	return g.rand
+}
+
+// Locale returns the locale set with WithLocale, ie "da_DK".
+func (g *Gen) Locale() string {
+	return g.cfg.locale
+}
+
+// reported is the errors reported by Gen.Errorf in the running tests.
+var reported = struct {
+	sync.Mutex
+	errors map[string]bool
+}{errors: make(map[string]bool)}
+
+// Errorf reports an error on the test the value is generated for, ie when a generator
+// cannot honor the Config. The generator is expected to return a value anyway.
+// The same error is only reported once per test, as it is likely to repeat for each value.
+func (g *Gen) Errorf(format string, args ...any) {
+	var (
+		msg = fmt.Sprintf(format, args...)
+		key = g.t.Name() + "\x00" + msg
+	)
+
+	reported.Lock()
+	var seen = reported.errors[key]
+	reported.errors[key] = true
+	reported.Unlock()
+
+	if seen {
+		return
+	}
+
+	g.t.Cleanup(func() {
+		reported.Lock()
+		defer reported.Unlock()
+		delete(reported.errors, key)
+	})
+	errorf(g.t, "%s", msg)
+}
+
+// Path of the value currently being generated, prefixed by the name of the root type,
+// ie Order.Customer.Address.
+func (g *Gen) Path() string {
+	var name = g.root.Name()
+	if name == "" {
+		name = g.root.String()
+	}
+
+	return strings.Join(append([]string{name}, g.path...), ".")
+}
+
+// Parent returns the type of the struct that has the field currently being generated.
+// It is nil when the value is not a struct field.
+func (g *Gen) Parent() reflect.Type {
+	if len(g.parents) == 0 {
+		return nil
+	}
+
+	return g.parents[len(g.parents)-1]
+}
+
+// leadsToOverride reports if the value currently being generated
+// is a parent of a field set by an override.
+func (g *Gen) leadsToOverride() bool {
+	if len(g.overrides) == 0 || len(g.path) == 0 {
+		return false
+	}
+
+	var prefix = strings.Join(g.path, ".") + "."
+	for _, o := range g.overrides {
+		if strings.HasPrefix(o.path, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *Gen) pushField(parent reflect.Type, name string) {
+	g.path = append(g.path, name)
+	g.parents = append(g.parents, parent)
+}
+
+func (g *Gen) popField() {
+	g.path = g.path[:len(g.path)-1]
+	g.parents = g.parents[:len(g.parents)-1]
+}
+
+// MakeFrom creates a value U using the Config and test of g. It is meant to be
+// called from a generator added with WithGenFunc, to build a composite value.
+// Sticky values and the other generators of the Config are respected.
+// When called for the type the generator itself generates, the built-in value is returned.
+func MakeFrom[U any](g *Gen) U {
+	var typ = reflect.TypeFor[U]()
+	return valueOf[U](g, typ, g.cfg.make(g, typ))
+}
@@ -7,7 +7,7 @@ import (
 	"time"
 )
 
-func Equal[T comparable](t *testing.T, expected, got T) bool {
+func Equal[T comparable](t testing.TB, expected, got T) bool {
 	t.Helper()
 	if expected != got {
 		t.Logf(`
@@ -20,7 +20,7 @@ Expected: %v
 	return true
 }
 
-func NotEqual[T comparable](t *testing.T, unexpected, got T) bool {
+func NotEqual[T comparable](t testing.TB, unexpected, got T) bool {
 	t.Helper()
 	if unexpected == got {
 		t.Logf(`
@@ -33,7 +33,7 @@ Expected: %v
 	return true
 }
 
-func NotNil(t *testing.T, got any) bool {
+func NotNil(t testing.TB, got any) bool {
 	t.Helper()
 	if reflect.ValueOf(got).IsNil() {
 		t.Logf("Expected a value, but got nil")
@@ -44,7 +44,7 @@ func NotNil(t *testing.T, got any) bool {
 	return true
 }
 
-func Match[T ~string](t *testing.T, expectedRE string, got T) bool {
+func Match[T ~string](t testing.TB, expectedRE string, got T) bool {
 	t.Helper()
 	re, err := regexp.Compile(expectedRE)
 	if err != nil {
@@ -64,7 +64,7 @@ Must match %q
 	return true
 }
 
-func OneOf[T comparable](t *testing.T, items []T, got T) bool {
+func OneOf[T comparable](t testing.TB, items []T, got T) bool {
 	t.Helper()
 	var found = false
 	for _, item := range items {
@@ -83,7 +83,7 @@ func OneOf[T comparable](t *testing.T, items []T, got T) bool {
 	return true
 }
 
-func NoneZero[T any, E ~[]T](t *testing.T, got E) bool {
+func NoneZero[T any, E ~[]T](t testing.TB, got E) bool {
 	t.Helper()
 	for _, e := range got {
 		if reflect.ValueOf(e).IsZero() {
@@ -94,7 +94,7 @@ func NoneZero[T any, E ~[]T](t *testing.T, got E) bool {
 	return true
 }
 
-func NotZero[T any](t *testing.T, got T) bool {
+func NotZero[T any](t testing.TB, got T) bool {
 	t.Helper()
 	if reflect.ValueOf(got).IsZero() {
 		t.Logf("Value %T was zero: %v", got, got)
@@ -103,7 +103,7 @@ func NotZero[T any](t *testing.T, got T) bool {
 	return true
 }
 
-func TimeWithinWindow(t *testing.T, expected time.Time, got time.Time, window time.Duration) bool {
+func TimeWithinWindow(t testing.TB, expected time.Time, got time.Time, window time.Duration) bool {
 	var (
 		from = expected.Add(-1 * window)
 		to   = expected.Add(window)
@@ -122,7 +122,7 @@ func TimeWithinWindow(t *testing.T, expected time.Time, got time.Time, window ti
 	return true
 }
 
-func NoError(t *testing.T, got error) bool {
+func NoError(t testing.TB, got error) bool {
 	t.Helper()
 	if got != nil {
 		t.Logf("Unexpected error: %s", got)
@@ -133,7 +133,7 @@ func NoError(t *testing.T, got error) bool {
 	return true
 }
 
-func Error(t *testing.T, got error) bool {
+func Error(t testing.TB, got error) bool {
 	t.Helper()
 	if got == nil {
 		t.Logf("Expected error: %s", got)
@@ -143,3 +143,28 @@ func Error(t *testing.T, got error) bool {
 
 	return true
 }
+
+func True(t testing.TB, got bool) bool {
+	t.Helper()
+	if !got {
+		t.Logf("Expected true, but got false")
+		t.Fail()
+		return false
+	}
+
+	return true
+}
+
+func Panic(t testing.TB, fn func()) (recovered any) {
+	t.Helper()
+	defer func() {
+		recovered = recover()
+		if recovered == nil {
+			t.Logf("Expected a panic, but got none")
+			t.Fail()
+		}
+	}()
+
+	fn()
+	return nil
+}
@@ -0,0 +1,21 @@
+package generate
+
+import "reflect"
+
+func Array(typ reflect.Type, maker func(typ reflect.Type) reflect.Value) reflect.Value {
+	var (
+		eleType  = typ.Elem()
+		theArray = reflect.New(typ).Elem()
+	)
+
+	for i := 0; i < typ.Len(); i++ {
+		v := maker(eleType)
+		if !v.Type().AssignableTo(eleType) {
+			v = v.Convert(eleType)
+		}
+
+		theArray.Index(i).Set(v)
+	}
+
+	return theArray
+}
@@ -0,0 +1,21 @@
+package generate
+
+import "reflect"
+
+func Chan(typ reflect.Type, maker func(typ reflect.Type) reflect.Value, size int) reflect.Value {
+	var (
+		eleType = typ.Elem()
+		theChan = reflect.MakeChan(reflect.ChanOf(reflect.BothDir, eleType), size)
+	)
+
+	for i := 0; i < size; i++ {
+		v := maker(eleType)
+		if !v.Type().AssignableTo(eleType) {
+			v = v.Convert(eleType)
+		}
+
+		theChan.Send(v)
+	}
+
+	return theChan.Convert(typ)
+}
@@ -0,0 +1,10 @@
+package generate
+
+import (
+	"math/rand/v2"
+	"reflect"
+)
+
+func Complex128(rand *rand.Rand) reflect.Value {
+	return reflect.ValueOf(complex(rand.Float64(), rand.Float64()))
+}
@@ -0,0 +1,39 @@
+package generate
+
+import (
+	"math/rand/v2"
+	"reflect"
+	"time"
+)
+
+func Duration(r *rand.Rand, min, max, unit time.Duration) reflect.Value {
+	var (
+		lower = ceilDiv(min, unit)
+		upper = floorDiv(max, unit)
+	)
+
+	if lower > upper {
+		return reflect.ValueOf(min)
+	}
+
+	var n = IntRange(r, reflect.TypeFor[int64](), int64(lower), int64(upper)).Int()
+	return reflect.ValueOf(time.Duration(n) * unit)
+}
+
+func floorDiv(d, unit time.Duration) time.Duration {
+	var q = d / unit
+	if d%unit != 0 && d < 0 {
+		q--
+	}
+
+	return q
+}
+
+func ceilDiv(d, unit time.Duration) time.Duration {
+	var q = d / unit
+	if d%unit != 0 && d > 0 {
+		q++
+	}
+
+	return q
+}
@@ -0,0 +1,80 @@
+package generate
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+)
+
+func Edge(r *rand.Rand, typ reflect.Type) reflect.Value {
+	var val = reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lower, upper := IntBounds(typ)
+		var edges = []int64{0, 1, -1, lower, lower + 1, upper, upper - 1}
+		val.SetInt(edges[r.IntN(len(edges))])
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		_, upper := UintBounds(typ)
+		var edges = []uint64{0, 1, upper, upper - 1}
+		val.SetUint(edges[r.IntN(len(edges))])
+	case reflect.Float32, reflect.Float64:
+		val.SetFloat(edgeFloat(r, typ.Bits()))
+	case reflect.Complex64, reflect.Complex128:
+		val.SetComplex(complex(edgeFloat(r, typ.Bits()/2), edgeFloat(r, typ.Bits()/2)))
+	}
+
+	return val
+}
+
+func edgeFloat(r *rand.Rand, bits int) float64 {
+	var (
+		largest, smallest = math.MaxFloat64, math.SmallestNonzeroFloat64
+	)
+
+	if bits == 32 {
+		largest, smallest = math.MaxFloat32, math.SmallestNonzeroFloat32
+	}
+
+	var edges = []float64{
+		0, math.Copysign(0, -1), 1, -1,
+		math.NaN(), math.Inf(1), math.Inf(-1),
+		largest, -largest, smallest, -smallest,
+	}
+
+	return edges[r.IntN(len(edges))]
+}
+
+func FullRange(r *rand.Rand, typ reflect.Type) reflect.Value {
+	var val = reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lower, upper := IntBounds(typ)
+		return IntRange(r, typ, lower, upper)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lower, upper := UintBounds(typ)
+		return UintRange(r, typ, lower, upper)
+	case reflect.Float32, reflect.Float64:
+		val.SetFloat(finiteFloat(r, typ.Bits()))
+	case reflect.Complex64, reflect.Complex128:
+		val.SetComplex(complex(finiteFloat(r, typ.Bits()/2), finiteFloat(r, typ.Bits()/2)))
+	}
+
+	return val
+}
+
+// finiteFloat picks random bits until they are a finite float,
+// so all exponents are equally likely.
+func finiteFloat(r *rand.Rand, bits int) float64 {
+	for {
+		var f float64
+		if bits == 32 {
+			f = float64(math.Float32frombits(r.Uint32()))
+		} else {
+			f = math.Float64frombits(r.Uint64())
+		}
+
+		if !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f
+		}
+	}
+}
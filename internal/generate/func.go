@@ -0,0 +1,21 @@
+package generate
+
+import "reflect"
+
+// Func returns a func of typ, that returns the same generated results on every call.
+func Func(typ reflect.Type, maker func(typ reflect.Type) reflect.Value) reflect.Value {
+	var results = make([]reflect.Value, typ.NumOut())
+	for i := range results {
+		outType := typ.Out(i)
+		v := maker(outType)
+		if !v.Type().AssignableTo(outType) {
+			v = v.Convert(outType)
+		}
+
+		results[i] = v
+	}
+
+	return reflect.MakeFunc(typ, func(args []reflect.Value) []reflect.Value {
+		return results
+	})
+}
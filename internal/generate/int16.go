@@ -7,5 +7,5 @@ import (
 )
 
 func Int16(rand *rand.Rand) reflect.Value {
-	return reflect.ValueOf(int16(rand.IntN(math.MaxInt16)))
+	return reflect.ValueOf(int16(rand.IntN(math.MaxInt16 + 1)))
 }
@@ -7,5 +7,5 @@ import (
 )
 
 func Int8(rand *rand.Rand) reflect.Value {
-	return reflect.ValueOf(int8(rand.IntN(math.MaxInt8)))
+	return reflect.ValueOf(int8(rand.IntN(math.MaxInt8 + 1)))
 }
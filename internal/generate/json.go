@@ -0,0 +1,48 @@
+package generate
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+)
+
+// JSON generates a value that is compatible with encoding/json, ie a tree of
+// map[string]any, []any, float64, string, bool and nil. Objects and arrays are
+// nested at most depth levels and have at most breadth elements.
+// A nil value is returned as the invalid reflect.Value.
+func JSON(r *rand.Rand, depth, breadth int) reflect.Value {
+	return reflect.ValueOf(jsonValue(r, depth, breadth))
+}
+
+func jsonValue(r *rand.Rand, depth, breadth int) any {
+	var kinds = 4
+	if depth > 1 {
+		kinds = 6
+	}
+
+	switch r.IntN(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return r.IntN(2) == 0
+	case 2:
+		return math.Round(r.NormFloat64()*1000) / 100
+	case 3:
+		return chars(r, 1+r.IntN(16))
+	case 4:
+		var array = make([]any, r.IntN(breadth+1))
+		for i := range array {
+			array[i] = jsonValue(r, depth-1, breadth)
+		}
+		return array
+	default:
+		var (
+			size   = r.IntN(breadth + 1)
+			object = make(map[string]any, size)
+		)
+		for len(object) < size {
+			object[chars(r, 1+r.IntN(8))] = jsonValue(r, depth-1, breadth)
+		}
+		return object
+	}
+}
@@ -0,0 +1,36 @@
+package generate
+
+import (
+	_ "embed"
+	"math/rand/v2"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata"
+)
+
+// zones is the canonical names of the IANA time zones.
+//
+//go:embed zones.txt
+var zones string
+
+var (
+	zoneNames = strings.Fields(zones)
+	locations sync.Map
+)
+
+func Location(r *rand.Rand) reflect.Value {
+	var name = zoneNames[r.IntN(len(zoneNames))]
+	if loc, ok := locations.Load(name); ok {
+		return reflect.ValueOf(loc)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic("testdata: " + err.Error())
+	}
+
+	locations.Store(name, loc)
+	return reflect.ValueOf(loc)
+}
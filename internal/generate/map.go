@@ -2,6 +2,12 @@ package generate
 
 import "reflect"
 
+// keyAttempts is the number of keys generated per entry in a map,
+// before giving up on finding a unique key.
+const keyAttempts = 10
+
+// Map generates a map with size unique keys. If no unique keys are found
+// within a number of attempts, the map will have fewer entries than size.
 func Map(typ reflect.Type, maker func(typ reflect.Type) reflect.Value, size int) reflect.Value {
 	var (
 		keyType = typ.Key()
@@ -9,12 +15,16 @@ func Map(typ reflect.Type, maker func(typ reflect.Type) reflect.Value, size int)
 		theMap  = reflect.MakeMap(typ)
 	)
 
-	for i := 0; i < size; i++ {
+	for attempt := 0; theMap.Len() < size && attempt < size*keyAttempts; attempt++ {
 		key := maker(keyType)
 		if !key.Type().AssignableTo(keyType) {
 			key = key.Convert(keyType)
 		}
 
+		if theMap.MapIndex(key).IsValid() {
+			continue
+		}
+
 		val := maker(valType)
 		if !val.Type().AssignableTo(valType) {
 			val = val.Convert(valType)
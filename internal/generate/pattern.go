@@ -0,0 +1,148 @@
+package generate
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+)
+
+// patternRepeat is the most repetitions added by *, + and {n,}.
+const patternRepeat = 8
+
+func ParsePattern(pattern string) (*syntax.Regexp, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := satisfiable(re); err != nil {
+		return nil, err
+	}
+
+	return re, nil
+}
+
+// satisfiable returns an error if no string matches re. The parser already
+// drops alternatives that match nothing, so any part that cannot match fails.
+func satisfiable(re *syntax.Regexp) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return fmt.Errorf("%s matches no string", re)
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return fmt.Errorf("empty character class %s matches no string", re)
+		}
+	case syntax.OpStar, syntax.OpQuest:
+		return nil
+	case syntax.OpRepeat:
+		if re.Min == 0 {
+			return nil
+		}
+	}
+
+	for _, sub := range re.Sub {
+		if err := satisfiable(sub); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func Pattern(r *rand.Rand, typ reflect.Type, re *syntax.Regexp) reflect.Value {
+	var (
+		b   strings.Builder
+		val = reflect.New(typ).Elem()
+	)
+
+	writePattern(r, &b, re)
+	val.SetString(b.String())
+
+	return val
+}
+
+func writePattern(r *rand.Rand, b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		b.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		b.WriteRune(charClass(r, re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteRune(rune(' ' + r.IntN('~'-' '+1)))
+	case syntax.OpCapture:
+		writePattern(r, b, re.Sub[0])
+	case syntax.OpStar:
+		repeat(r, b, re.Sub[0], 0, patternRepeat)
+	case syntax.OpPlus:
+		repeat(r, b, re.Sub[0], 1, 1+patternRepeat)
+	case syntax.OpQuest:
+		repeat(r, b, re.Sub[0], 0, 1)
+	case syntax.OpRepeat:
+		var to = re.Max
+		if to < 0 {
+			to = re.Min + patternRepeat
+		}
+		repeat(r, b, re.Sub[0], re.Min, to)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writePattern(r, b, sub)
+		}
+	case syntax.OpAlternate:
+		writePattern(r, b, re.Sub[r.IntN(len(re.Sub))])
+	}
+}
+
+func repeat(r *rand.Rand, b *strings.Builder, re *syntax.Regexp, from, to int) {
+	if satisfiable(re) != nil {
+		// only optional parts can match nothing, ie [^\x00-\x{10FFFF}]*
+		return
+	}
+
+	var count = from + r.IntN(to-from+1)
+	for i := 0; i < count; i++ {
+		writePattern(r, b, re)
+	}
+}
+
+// charClass picks a rune from the ranges of a character class. Printable
+// ASCII is preferred, so negated classes like [^a-z] stay readable.
+func charClass(r *rand.Rand, ranges []rune) rune {
+	var printable []rune
+	for i := 0; i < len(ranges); i += 2 {
+		from, to := max(ranges[i], ' '), min(ranges[i+1], '~')
+		if from <= to {
+			printable = append(printable, from, to)
+		}
+	}
+
+	if len(printable) > 0 {
+		ranges = printable
+	}
+
+	var total = 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+
+	var n = r.IntN(total)
+	for i := 0; i < len(ranges); i += 2 {
+		var size = int(ranges[i+1]-ranges[i]) + 1
+		if n < size {
+			return validRune(ranges[i]+rune(n), ranges[0])
+		}
+		n -= size
+	}
+
+	return ranges[0]
+}
+
+// validRune replaces surrogate halves, which are not valid in a string.
+func validRune(c, fallback rune) rune {
+	if c >= 0xD800 && c <= 0xDFFF {
+		return fallback
+	}
+
+	return c
+}
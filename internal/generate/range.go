@@ -0,0 +1,52 @@
+package generate
+
+import (
+	"math"
+	"math/rand/v2"
+	"reflect"
+)
+
+func IntRange(r *rand.Rand, typ reflect.Type, min, max int64) reflect.Value {
+	var (
+		span = uint64(max - min)
+		val  = reflect.New(typ).Elem()
+	)
+
+	if span == math.MaxUint64 {
+		val.SetInt(int64(r.Uint64()))
+	} else {
+		val.SetInt(min + int64(r.Uint64N(span+1)))
+	}
+
+	return val
+}
+
+func UintRange(r *rand.Rand, typ reflect.Type, min, max uint64) reflect.Value {
+	var (
+		span = max - min
+		val  = reflect.New(typ).Elem()
+	)
+
+	if span == math.MaxUint64 {
+		val.SetUint(r.Uint64())
+	} else {
+		val.SetUint(min + r.Uint64N(span+1))
+	}
+
+	return val
+}
+
+func FloatRange(r *rand.Rand, typ reflect.Type, min, max float64) reflect.Value {
+	var val = reflect.New(typ).Elem()
+	val.SetFloat(min + r.Float64()*(max-min))
+	return val
+}
+
+func IntBounds(typ reflect.Type) (int64, int64) {
+	var bits = typ.Bits()
+	return -1 << (bits - 1), 1<<(bits-1) - 1
+}
+
+func UintBounds(typ reflect.Type) (uint64, uint64) {
+	return 0, math.MaxUint64 >> (64 - typ.Bits())
+}
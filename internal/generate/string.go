@@ -1,9 +1,7 @@
 package generate
 
 import (
-	"fmt"
 	"math/rand/v2"
-	"reflect"
 )
 
 var (
@@ -11,13 +9,11 @@ var (
 	charCount = len(charList)
 )
 
-func String(r *rand.Rand, typ reflect.Type, size uint16) reflect.Value {
+func chars(r *rand.Rand, size int) string {
 	var b []byte
-	for i := 0; i < int(size); i++ {
+	for i := 0; i < size; i++ {
 		b = append(b, charList[r.IntN(charCount)])
 	}
 
-	s := fmt.Sprintf("%s-%s", typ.Name(), string(b))
-
-	return reflect.ValueOf(s)
+	return string(b)
 }
@@ -2,14 +2,17 @@ package generate
 
 import "reflect"
 
-func Struct(typ reflect.Type, maker func(typ reflect.Type) reflect.Value) reflect.Value {
+func Struct(typ reflect.Type, maker func(field reflect.StructField) reflect.Value) reflect.Value {
 	var val = reflect.Indirect(reflect.New(typ))
 	for _, f := range reflect.VisibleFields(typ) {
-		if !f.IsExported() {
+		if !f.IsExported() || promoted(typ, f.Index) {
 			continue
 		}
 		var field = val.FieldByIndex(f.Index)
-		var v = maker(field.Type())
+		var v = maker(f)
+		if !v.IsValid() {
+			continue
+		}
 		if v.Type().AssignableTo(field.Type()) {
 			field.Set(v)
 		} else {
@@ -19,3 +22,17 @@ func Struct(typ reflect.Type, maker func(typ reflect.Type) reflect.Value) reflec
 
 	return val
 }
+
+// promoted reports if the field at index is promoted through an embedded field
+// that is generated itself, or through an embedded pointer that cannot be set.
+func promoted(typ reflect.Type, index []int) bool {
+	for _, i := range index[:len(index)-1] {
+		var embedded = typ.Field(i)
+		if embedded.IsExported() || embedded.Type.Kind() == reflect.Pointer {
+			return true
+		}
+		typ = embedded.Type
+	}
+
+	return false
+}
@@ -7,5 +7,5 @@ import (
 )
 
 func Uint16(rand *rand.Rand) reflect.Value {
-	return reflect.ValueOf(uint16(rand.UintN(math.MaxInt16)))
+	return reflect.ValueOf(uint16(rand.UintN(math.MaxUint16 + 1)))
 }
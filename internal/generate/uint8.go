@@ -7,5 +7,5 @@ import (
 )
 
 func Uint8(rand *rand.Rand) reflect.Value {
-	return reflect.ValueOf(uint8(rand.UintN(math.MaxInt8)))
+	return reflect.ValueOf(uint8(rand.UintN(math.MaxUint8 + 1)))
 }
@@ -0,0 +1,10 @@
+package generate
+
+import (
+	"math/rand/v2"
+	"reflect"
+)
+
+func Uintptr(rand *rand.Rand) reflect.Value {
+	return reflect.ValueOf(uintptr(rand.Uint64()))
+}
@@ -0,0 +1,32 @@
+package seed
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+)
+
+// Bytes returns a rand.Source that reads its entropy from data, ie the input of a fuzz test.
+// When data is exhausted, it continues with a stream seeded by data, so the same
+// data always produces the same values.
+func Bytes(data []byte) rand.Source {
+	return &bytesSource{
+		data: data,
+		rest: rand.NewPCG(hash(string(data)), uint64(len(data))),
+	}
+}
+
+type bytesSource struct {
+	data []byte
+	rest *rand.PCG
+}
+
+func (src *bytesSource) Uint64() uint64 {
+	if len(src.data) == 0 {
+		return src.rest.Uint64()
+	}
+
+	var buf [8]byte
+	n := copy(buf[:], src.data)
+	src.data = src.data[n:]
+	return binary.LittleEndian.Uint64(buf[:])
+}
@@ -0,0 +1,144 @@
+package seed
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"sync"
+)
+
+type testingT interface {
+	Name() string
+	Cleanup(fn func())
+}
+
+// reporter is implemented by a testingT that can report on the outcome of a test.
+type reporter interface {
+	Failed() bool
+	Logf(format string, args ...any)
+}
+
+// New returns a Manager that uses the fixed seed.
+func New(seed uint64) *Manager {
+	return &Manager{
+		seed:    seed,
+		streams: make(map[string]*rand.Rand),
+	}
+}
+
+// master is the seed shared by all Managers from Random, so a failing
+// run is replayed by a single seed regardless of how many Configs it used.
+var master = rand.Uint64()
+
+// ordinals counts the streams handed out per test by Managers from Random.
+// As they share the master seed, each Manager mixes its ordinal within the
+// test into the stream, so two Configs do not generate the same values.
+var ordinals = struct {
+	sync.Mutex
+	counts map[string]uint64
+}{counts: make(map[string]uint64)}
+
+// Random returns a Manager with the process-wide master seed, unless a seed
+// is given to replay a previous run.
+func Random() *Manager {
+	var mgr = New(master)
+	mgr.replay = true
+	return mgr
+}
+
+// Manager hands out a random stream per test. The stream is derived from
+// the master seed and the name of the test, so a test gets the same values
+// regardless of which other tests ran before it.
+type Manager struct {
+	once    sync.Once
+	mu      sync.Mutex
+	seed    uint64
+	replay  bool
+	streams map[string]*rand.Rand
+}
+
+func (mgr *Manager) Seed() uint64 {
+	mgr.once.Do(mgr.resolve)
+	return mgr.seed
+}
+
+func (mgr *Manager) Rand(t testingT) *rand.Rand {
+	var seed = mgr.Seed()
+
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	var name = t.Name()
+	r, ok := mgr.streams[name]
+	if !ok {
+		r = rand.New(rand.NewPCG(seed, hash(stream(name, mgr.ordinal(name)))))
+		mgr.streams[name] = r
+		t.Cleanup(mgr.cleanup(t, seed))
+	}
+
+	return r
+}
+
+// ordinal of the stream for the test among the Managers sharing the master seed,
+// in the order they are first used. A Manager with a fixed seed is always the first.
+func (mgr *Manager) ordinal(name string) uint64 {
+	if !mgr.replay {
+		return 0
+	}
+
+	ordinals.Lock()
+	defer ordinals.Unlock()
+
+	var n = ordinals.counts[name]
+	ordinals.counts[name] = n + 1
+	return n
+}
+
+// resolve the seed to replay, if any. It is done on first use,
+// as the flags are not parsed when the Manager is created.
+func (mgr *Manager) resolve() {
+	if !mgr.replay {
+		return
+	}
+
+	seed, ok, err := Replay()
+	if err != nil {
+		panic(fmt.Sprintf("testdata: %s", err))
+	}
+
+	if ok {
+		mgr.seed = seed
+	}
+}
+
+func (mgr *Manager) cleanup(t testingT, seed uint64) func() {
+	var name = t.Name()
+	return func() {
+		if r, ok := t.(reporter); ok && r.Failed() {
+			r.Logf("testdata: seed %d, replay with -%s=%d or %s=%d", seed, FlagName, seed, EnvName, seed)
+		}
+
+		mgr.mu.Lock()
+		delete(mgr.streams, name)
+		mgr.mu.Unlock()
+
+		ordinals.Lock()
+		delete(ordinals.counts, name)
+		ordinals.Unlock()
+	}
+}
+
+// stream names the stream of a test, suffixed by its ordinal after the first.
+func stream(name string, ordinal uint64) string {
+	if ordinal == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s#%d", name, ordinal)
+}
+
+func hash(name string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return h.Sum64()
+}
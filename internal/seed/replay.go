@@ -0,0 +1,35 @@
+package seed
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	FlagName = "testdata.seed"
+	EnvName  = "TESTDATA_SEED"
+)
+
+var flagSeed = flag.String(FlagName, "", "master seed used to replay the values generated by testdata")
+
+// Replay returns the seed given by the -testdata.seed flag or the
+// TESTDATA_SEED environment variable. The flag takes precedence.
+func Replay() (uint64, bool, error) {
+	var value = *flagSeed
+	if value == "" {
+		value = os.Getenv(EnvName)
+	}
+
+	if value == "" {
+		return 0, false, nil
+	}
+
+	seed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid seed %q", value)
+	}
+
+	return seed, true, nil
+}
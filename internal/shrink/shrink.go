@@ -0,0 +1,314 @@
+package shrink
+
+import (
+	"math"
+	"reflect"
+)
+
+// Candidates returns values that are simpler than v, ordered with the
+// most aggressive simplification first. All candidates have the type of v.
+func Candidates(v reflect.Value) []reflect.Value {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return []reflect.Value{reflect.Zero(v.Type())}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return ints(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uints(v)
+	case reflect.Float32, reflect.Float64:
+		return floats(v)
+	case reflect.String:
+		return strings(v)
+	case reflect.Slice:
+		return slices(v)
+	case reflect.Array:
+		return arrays(v)
+	case reflect.Map:
+		return maps(v)
+	case reflect.Struct:
+		return structs(v)
+	case reflect.Pointer:
+		return pointers(v)
+	case reflect.Interface:
+		return interfaces(v)
+	}
+
+	return nil
+}
+
+func ints(v reflect.Value) []reflect.Value {
+	var (
+		n          = v.Int()
+		candidates []reflect.Value
+	)
+
+	if n == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.Zero(v.Type()))
+	if n < 0 && n != math.MinInt64 && !v.OverflowInt(-n) {
+		candidates = append(candidates, newInt(v.Type(), -n))
+	}
+
+	for delta := n / 2; delta != 0; delta /= 2 {
+		candidates = append(candidates, newInt(v.Type(), n-delta))
+	}
+
+	return candidates
+}
+
+func uints(v reflect.Value) []reflect.Value {
+	var (
+		n          = v.Uint()
+		candidates []reflect.Value
+	)
+
+	if n == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.Zero(v.Type()))
+	for delta := n / 2; delta != 0; delta /= 2 {
+		candidates = append(candidates, newUint(v.Type(), n-delta))
+	}
+
+	return candidates
+}
+
+func floats(v reflect.Value) []reflect.Value {
+	var (
+		f          = v.Float()
+		candidates []reflect.Value
+	)
+
+	if f == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.Zero(v.Type()))
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return candidates
+	}
+
+	if f < 0 {
+		candidates = append(candidates, newFloat(v.Type(), -f))
+	}
+
+	if t := math.Trunc(f); t != f {
+		candidates = append(candidates, newFloat(v.Type(), t))
+	}
+
+	if half := f / 2; half != f && half != 0 {
+		candidates = append(candidates, newFloat(v.Type(), half))
+	}
+
+	return candidates
+}
+
+func strings(v reflect.Value) []reflect.Value {
+	var (
+		s          = []rune(v.String())
+		candidates []reflect.Value
+	)
+
+	if len(s) == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.Zero(v.Type()))
+	if len(s) > 1 {
+		candidates = append(candidates,
+			newString(v.Type(), string(s[:len(s)/2])),
+			newString(v.Type(), string(s[len(s)/2:])),
+		)
+	}
+
+	for i := range s {
+		var shorter = append(append([]rune{}, s[:i]...), s[i+1:]...)
+		candidates = append(candidates, newString(v.Type(), string(shorter)))
+	}
+
+	return candidates
+}
+
+func slices(v reflect.Value) []reflect.Value {
+	var (
+		size       = v.Len()
+		candidates []reflect.Value
+	)
+
+	if v.IsNil() || size == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.MakeSlice(v.Type(), 0, 0))
+	if size > 1 {
+		candidates = append(candidates,
+			copySlice(v.Slice(0, size/2)),
+			copySlice(v.Slice(size/2, size)),
+		)
+	}
+
+	for i := 0; i < size; i++ {
+		var shorter = reflect.AppendSlice(copySlice(v.Slice(0, i)), v.Slice(i+1, size))
+		candidates = append(candidates, shorter)
+	}
+
+	for i := 0; i < size; i++ {
+		for _, element := range Candidates(v.Index(i)) {
+			var c = copySlice(v)
+			c.Index(i).Set(element)
+			candidates = append(candidates, c)
+		}
+	}
+
+	return candidates
+}
+
+func arrays(v reflect.Value) []reflect.Value {
+	var candidates []reflect.Value
+	for i := 0; i < v.Len(); i++ {
+		for _, element := range Candidates(v.Index(i)) {
+			var c = clone(v)
+			c.Index(i).Set(element)
+			candidates = append(candidates, c)
+		}
+	}
+
+	return candidates
+}
+
+func maps(v reflect.Value) []reflect.Value {
+	var candidates []reflect.Value
+	if v.IsNil() || v.Len() == 0 {
+		return nil
+	}
+
+	candidates = append(candidates, reflect.MakeMap(v.Type()))
+	var keys = v.MapKeys()
+	for _, key := range keys {
+		var c = copyMap(v)
+		c.SetMapIndex(key, reflect.Value{})
+		candidates = append(candidates, c)
+	}
+
+	for _, key := range keys {
+		for _, value := range Candidates(v.MapIndex(key)) {
+			var c = copyMap(v)
+			c.SetMapIndex(key, value)
+			candidates = append(candidates, c)
+		}
+	}
+
+	return candidates
+}
+
+func structs(v reflect.Value) []reflect.Value {
+	var (
+		typ        = v.Type()
+		candidates []reflect.Value
+	)
+
+	for i := 0; i < typ.NumField(); i++ {
+		if !typ.Field(i).IsExported() || v.Field(i).IsZero() {
+			continue
+		}
+
+		var c = clone(v)
+		c.Field(i).Set(reflect.Zero(typ.Field(i).Type))
+		candidates = append(candidates, c)
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		if !typ.Field(i).IsExported() {
+			continue
+		}
+
+		for _, field := range Candidates(v.Field(i)) {
+			var c = clone(v)
+			c.Field(i).Set(field)
+			candidates = append(candidates, c)
+		}
+	}
+
+	return candidates
+}
+
+func pointers(v reflect.Value) []reflect.Value {
+	if v.IsNil() {
+		return nil
+	}
+
+	var candidates []reflect.Value
+	for _, elem := range Candidates(v.Elem()) {
+		var p = reflect.New(v.Type().Elem())
+		p.Elem().Set(elem)
+		candidates = append(candidates, p)
+	}
+
+	return candidates
+}
+
+func interfaces(v reflect.Value) []reflect.Value {
+	if v.IsNil() {
+		return nil
+	}
+
+	var candidates []reflect.Value
+	for _, elem := range Candidates(v.Elem()) {
+		var c = reflect.New(v.Type()).Elem()
+		c.Set(elem)
+		candidates = append(candidates, c)
+	}
+
+	return candidates
+}
+
+func clone(v reflect.Value) reflect.Value {
+	var c = reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+func copySlice(v reflect.Value) reflect.Value {
+	var c = reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	reflect.Copy(c, v)
+	return c
+}
+
+func copyMap(v reflect.Value) reflect.Value {
+	var c = reflect.MakeMapWithSize(v.Type(), v.Len())
+	var iter = v.MapRange()
+	for iter.Next() {
+		c.SetMapIndex(iter.Key(), iter.Value())
+	}
+
+	return c
+}
+
+func newInt(typ reflect.Type, n int64) reflect.Value {
+	var v = reflect.New(typ).Elem()
+	v.SetInt(n)
+	return v
+}
+
+func newUint(typ reflect.Type, n uint64) reflect.Value {
+	var v = reflect.New(typ).Elem()
+	v.SetUint(n)
+	return v
+}
+
+func newFloat(typ reflect.Type, f float64) reflect.Value {
+	var v = reflect.New(typ).Elem()
+	v.SetFloat(f)
+	return v
+}
+
+func newString(typ reflect.Type, s string) reflect.Value {
+	var v = reflect.New(typ).Elem()
+	v.SetString(s)
+	return v
+}
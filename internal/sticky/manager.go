@@ -2,6 +2,7 @@ package sticky
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 
 	"github.com/kyuff/testdata/internal/generate"
@@ -13,7 +14,12 @@ type testingT interface {
 }
 
 type (
-	TestValues map[reflect.Type]reflect.Value
+	// Key of a sticky value. Values made sticky without a name have the empty Name.
+	Key struct {
+		Type reflect.Type
+		Name string
+	}
+	TestValues map[Key]reflect.Value
 	TestScope  map[string]TestValues
 )
 
@@ -28,7 +34,17 @@ type Manager struct {
 	scopes TestScope
 }
 
-func (mgr *Manager) HasValue(t testingT, typ reflect.Type) (reflect.Value, bool) {
+// HasValue returns the sticky value of typ with the name in the test, or in one of its parent tests.
+func (mgr *Manager) HasValue(t testingT, typ reflect.Type, name string) (reflect.Value, bool) {
+	return mgr.value(t, typ, name, true)
+}
+
+// HasOwnValue returns the sticky value of typ with the name added in the test itself.
+func (mgr *Manager) HasOwnValue(t testingT, typ reflect.Type, name string) (reflect.Value, bool) {
+	return mgr.value(t, typ, name, false)
+}
+
+func (mgr *Manager) value(t testingT, typ reflect.Type, name string, inherit bool) (reflect.Value, bool) {
 	mgr.mu.RLock()
 	defer mgr.mu.RUnlock()
 
@@ -37,12 +53,7 @@ func (mgr *Manager) HasValue(t testingT, typ reflect.Type) (reflect.Value, bool)
 		typ = typ.Elem()
 	}
 
-	values, ok := mgr.scopes[t.Name()]
-	if !ok {
-		return reflect.ValueOf(nil), false
-	}
-
-	value, ok := values[typ]
+	value, ok := mgr.lookup(t.Name(), Key{Type: typ, Name: name}, inherit)
 	if !ok {
 		return reflect.ValueOf(nil), false
 	}
@@ -54,6 +65,23 @@ func (mgr *Manager) HasValue(t testingT, typ reflect.Type) (reflect.Value, bool)
 	return value, true
 }
 
+// lookup finds the value of key in the scope of the test, or if inherit is set, the scopes of its parents.
+// Subtests are named by their parent followed by a /, ie TestOrder/paid.
+func (mgr *Manager) lookup(scope string, key Key, inherit bool) (reflect.Value, bool) {
+	for {
+		if value, ok := mgr.scopes[scope][key]; ok {
+			return value, true
+		}
+
+		i := strings.LastIndex(scope, "/")
+		if i < 0 || !inherit {
+			return reflect.Value{}, false
+		}
+
+		scope = scope[:i]
+	}
+}
+
 func (mgr *Manager) cleanup(scope string) func() {
 	return func() {
 		mgr.mu.Lock()
@@ -61,7 +89,8 @@ func (mgr *Manager) cleanup(scope string) func() {
 		delete(mgr.scopes, scope)
 	}
 }
-func (mgr *Manager) AddValue(t testingT, typ reflect.Type, val reflect.Value) {
+
+func (mgr *Manager) AddValue(t testingT, typ reflect.Type, name string, val reflect.Value) {
 	mgr.mu.Lock()
 	defer mgr.mu.Unlock()
 
@@ -71,6 +100,6 @@ func (mgr *Manager) AddValue(t testingT, typ reflect.Type, val reflect.Value) {
 		t.Cleanup(mgr.cleanup(t.Name()))
 	}
 
-	scope[typ] = val
+	scope[Key{Type: typ, Name: name}] = val
 	mgr.scopes[t.Name()] = scope
 }
@@ -0,0 +1,123 @@
+package tag
+
+import (
+	"fmt"
+	"reflect"
+	"regexp/syntax"
+	"strings"
+)
+
+// Name of the struct tag read by the parser.
+const Name = "testdata"
+
+// Tag is a parsed testdata struct tag.
+type Tag struct {
+	Skip    bool
+	Zero    bool
+	NonZero bool
+	Min     string
+	Max     string
+	Len     string
+	OneOf   []string
+	Pattern string
+}
+
+// Constrained reports if the Tag limits the values that can be generated.
+func (tag Tag) Constrained() bool {
+	return tag.Min != "" || tag.Max != "" || tag.Len != "" || len(tag.OneOf) > 0 || tag.Pattern != ""
+}
+
+// Lookup parses the testdata tag of the field, if any.
+func Lookup(field reflect.StructField) (Tag, bool, error) {
+	value, ok := field.Tag.Lookup(Name)
+	if !ok {
+		return Tag{}, false, nil
+	}
+
+	tag, err := Parse(value)
+	if err != nil {
+		return Tag{}, false, fmt.Errorf("field %s: %w", field.Name, err)
+	}
+
+	return tag, true, nil
+}
+
+// Parse a comma separated list of constraints, ie "min=1,max=99".
+// A pattern must be the last constraint, as it may contain commas.
+func Parse(value string) (Tag, error) {
+	var (
+		tag         Tag
+		constraints = value
+	)
+
+	if i := patternIndex(value); i >= 0 {
+		constraints, tag.Pattern = value[:i], value[i+len(patternKey):]
+		if tag.Pattern == "" {
+			return Tag{}, fmt.Errorf("invalid testdata tag %q: empty pattern", value)
+		}
+
+		if _, err := syntax.Parse(tag.Pattern, syntax.Perl); err != nil {
+			return Tag{}, fmt.Errorf("invalid testdata tag %q: %w", value, err)
+		}
+	}
+
+	for _, part := range strings.Split(constraints, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key, arg, hasArg := strings.Cut(part, "=")
+		switch {
+		case key == "skip" && !hasArg:
+			tag.Skip = true
+		case key == "zero" && !hasArg:
+			tag.Zero = true
+		case key == "nonzero" && !hasArg:
+			tag.NonZero = true
+		case key == "min" && arg != "":
+			tag.Min = arg
+		case key == "max" && arg != "":
+			tag.Max = arg
+		case key == "len" && arg != "":
+			tag.Len = arg
+		case key == "oneof" && arg != "":
+			tag.OneOf = strings.Split(arg, "|")
+		default:
+			return Tag{}, fmt.Errorf("invalid testdata tag %q", part)
+		}
+	}
+
+	if tag.Len != "" && (tag.Min != "" || tag.Max != "") {
+		return Tag{}, fmt.Errorf("invalid testdata tag %q: len cannot be combined with min or max", value)
+	}
+
+	if tag.Pattern != "" && (tag.Min != "" || tag.Max != "" || tag.Len != "" || len(tag.OneOf) > 0) {
+		return Tag{}, fmt.Errorf("invalid testdata tag %q: pattern cannot be combined with min, max, len or oneof", value)
+	}
+
+	if (tag.Zero || tag.Skip) && (tag.NonZero || tag.Constrained()) {
+		return Tag{}, fmt.Errorf("invalid testdata tag %q: zero and skip cannot be combined with other constraints", value)
+	}
+
+	return tag, nil
+}
+
+const patternKey = "pattern="
+
+// patternIndex returns the index of the pattern constraint in value, or -1.
+func patternIndex(value string) int {
+	var offset = 0
+	for {
+		var rest = strings.TrimLeft(value[offset:], " ")
+		if strings.HasPrefix(rest, patternKey) {
+			return len(value) - len(rest)
+		}
+
+		i := strings.IndexByte(value[offset:], ',')
+		if i < 0 {
+			return -1
+		}
+		offset += i + 1
+	}
+}
@@ -0,0 +1,69 @@
+package testdata
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const (
+	// defaultLength of generated slices, maps and channels.
+	defaultLength = 5
+	// defaultStringLength is the number of characters after the prefix of generated strings.
+	defaultStringLength = 16
+)
+
+type lengthRange struct {
+	min int
+	max int
+}
+
+func newLengthRange(min, max int) lengthRange {
+	if min < 0 || min > max {
+		panic(fmt.Sprintf("testdata: invalid length range [%d, %d]", min, max))
+	}
+
+	return lengthRange{min: min, max: max}
+}
+
+// lengthOf returns the length range configured for typ, either by type or by kind.
+func (cfg *Config) lengthOf(typ reflect.Type) (lengthRange, bool) {
+	if rng, ok := cfg.lengths[typ]; ok {
+		return rng, true
+	}
+
+	if rng, ok := cfg.kindLengths[typ.Kind()]; ok {
+		return rng, true
+	}
+
+	if typ.Kind() == reflect.String {
+		return lengthRange{min: defaultStringLength, max: defaultStringLength}, false
+	}
+
+	return lengthRange{min: defaultLength, max: defaultLength}, false
+}
+
+// length picks the length of a generated string, slice, map or channel of typ.
+// It reports if the length is configured, rather than the default length.
+func (cfg *Config) length(g *Gen, typ reflect.Type) (int, bool) {
+	var rng, configured = cfg.lengthOf(typ)
+	if rng.min == rng.max {
+		return rng.min, configured
+	}
+
+	return rng.min + g.rand.IntN(rng.max-rng.min+1), configured
+}
+
+// makeNil reports if a nested pointer, slice, map or interface should be left nil.
+// The parents of a field set by Set are always generated.
+func (cfg *Config) makeNil(g *Gen, typ reflect.Type) bool {
+	if cfg.nilProbability <= 0 || !g.nested || g.leadsToOverride() {
+		return false
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return g.rand.Float64() < cfg.nilProbability
+	default:
+		return false
+	}
+}
@@ -1,6 +1,7 @@
 package testdata
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -9,6 +10,49 @@ type testingT interface {
 	Cleanup(fn func())
 }
 
+// errorT is implemented by a testingT that can report errors, ie *testing.T.
+type errorT interface {
+	Errorf(format string, args ...any)
+}
+
+// fatalT is implemented by a testingT that can stop a failed test, ie *testing.T.
+type fatalT interface {
+	Fatalf(format string, args ...any)
+}
+
+// helperT is implemented by a testingT that can mark helper functions, ie *testing.T.
+type helperT interface {
+	Helper()
+}
+
+// fatalf reports an error on t and stops the test. If t cannot stop the test, it panics instead.
+func fatalf(t testingT, format string, args ...any) {
+	if h, ok := t.(helperT); ok {
+		h.Helper()
+	}
+
+	if f, ok := t.(fatalT); ok {
+		f.Fatalf(format, args...)
+		return
+	}
+
+	panic(fmt.Sprintf(format, args...))
+}
+
+// errorf reports an error on t. If t cannot report errors, it panics instead.
+func errorf(t testingT, format string, args ...any) {
+	if h, ok := t.(helperT); ok {
+		h.Helper()
+	}
+
+	if e, ok := t.(errorT); ok {
+		e.Errorf(format, args...)
+		return
+	}
+
+	panic(fmt.Sprintf(format, args...))
+}
+
 // Make creates a value T based on DefaultConfig
 func Make[T any](t testingT, modifications ...func(d T) T) T {
 	return MakeWith[T](t, DefaultConfig, modifications...)
@@ -16,22 +60,26 @@ func Make[T any](t testingT, modifications ...func(d T) T) T {
 
 // MakeWith creates a value T based on tge Config parameter
 func MakeWith[T any](t testingT, cfg *Config, modifications ...func(d T) T) T {
-	var (
-		typ  = reflect.TypeFor[T]()
-		val  = cfg.make(t, typ)
-		data T
-	)
+	var g = newGen(cfg, t, cfg.randFor(t), reflect.TypeFor[T]())
+	g.overrides = overrides(modifications)
+	return modify(t, makeWith[T](g), modifications)
+}
 
+func makeWith[T any](g *Gen) T {
+	return valueOf[T](g, g.root, g.cfg.make(g, g.root))
+}
+
+// valueOf converts the generated val to T.
+func valueOf[T any](g *Gen, typ reflect.Type, val reflect.Value) T {
+	var data T
 	if val.Type().ConvertibleTo(typ) {
 		data = val.Convert(typ).Interface().(T)
 	} else if val.Type().AssignableTo(typ) {
 		data = val.Interface().(T)
 	} else if val.CanConvert(typ) {
 		data = val.Convert(typ).Interface().(T)
-	}
-
-	for _, modify := range modifications {
-		data = modify(data)
+	} else {
+		g.cfg.unsupported(g, typ, fmt.Sprintf("generated value of type %s", val.Type()))
 	}
 
 	return data
@@ -40,18 +88,48 @@ func MakeWith[T any](t testingT, cfg *Config, modifications ...func(d T) T) T {
 // MakeSticky works like Make, except values created with it, will be sticky within a t.
 // That means a value of a specific type will be the same for all those types,
 // even if it's a field on another Make call, or it's to a pointer to the same type.
+//
+// Sticky values are inherited by subtests of t. Calling MakeSticky in a subtest
+// makes a new value, which shadows the value of the parent within the subtest.
 func MakeSticky[T any](t testingT, modifications ...func(d T) T) T {
 	return MakeStickyWith(t, DefaultConfig, modifications...)
 }
 
 // MakeStickyWith is similar to MakeSticky, just using cfg instead of DefaultConfig.
 func MakeStickyWith[T any](t testingT, cfg *Config, modifications ...func(d T) T) T {
+	return makeSticky(t, cfg, "", modifications)
+}
+
+// MakeStickyAs works like MakeSticky, except the value is sticky by the key. That allows several
+// sticky values of the same type within a t, ie the UserID of both a buyer and a seller.
+// The value is used for struct fields bound to the key using WithStickyKey, or calls to MakeStickyAs with the key.
+//
+//	buyer := testdata.MakeStickyAs[UserID](t, "buyer")
+func MakeStickyAs[T any](t testingT, key string, modifications ...func(d T) T) T {
+	return MakeStickyAsWith(t, DefaultConfig, key, modifications...)
+}
+
+// MakeStickyAsWith is similar to MakeStickyAs, just using cfg instead of DefaultConfig.
+func MakeStickyAsWith[T any](t testingT, cfg *Config, key string, modifications ...func(d T) T) T {
+	if key == "" {
+		panic("testdata: empty sticky key")
+	}
+
+	return makeSticky(t, cfg, key, modifications)
+}
+
+func makeSticky[T any](t testingT, cfg *Config, key string, modifications []func(d T) T) T {
 	var (
-		typ   = reflect.TypeFor[T]()
-		value = MakeWith[T](t, cfg, modifications...)
+		typ = reflect.TypeFor[T]()
+		g   = newGen(cfg, t, cfg.randFor(t), typ)
 	)
 
-	cfg.sticky.AddValue(t, typ, reflect.ValueOf(value))
+	// A subtest makes its own value, rather than the one of its parent test.
+	g.shadow = true
+	g.key = key
+	g.overrides = overrides(modifications)
+	var value = modify(t, makeWith[T](g), modifications)
+	cfg.sticky.AddValue(t, typ, key, reflect.ValueOf(value))
 
 	return value
 }
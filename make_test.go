@@ -1,9 +1,15 @@
 package testdata_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"math/rand/v2"
+	"reflect"
 	"testing"
 	"time"
+	"unicode/utf8"
+	"unsafe"
 
 	"github.com/kyuff/testdata"
 	"github.com/kyuff/testdata/internal/assert"
@@ -68,6 +74,141 @@ func TestMake(t *testing.T) {
 			assert.NotEqual(t, r1, r2)
 		})
 
+		t.Run("inherited by subtests", func(t *testing.T) {
+			var (
+				cfg = testdata.NewConfig()
+				id  = testdata.MakeStickyWith[ID](t, cfg)
+			)
+
+			t.Run("child", func(t *testing.T) {
+				t.Run("grandchild", func(t *testing.T) {
+					// act
+					got := testdata.MakeWith[Person](t, cfg)
+
+					// assert
+					assert.Equal(t, id, got.ID)
+				})
+			})
+		})
+
+		t.Run("shadowed in subtests", func(t *testing.T) {
+			var (
+				cfg   = testdata.NewConfig()
+				id    = testdata.MakeStickyWith[ID](t, cfg)
+				state = testdata.MakeStickyWith[State](t, cfg)
+			)
+
+			t.Run("child", func(t *testing.T) {
+				var child = testdata.MakeStickyWith[ID](t, cfg)
+
+				t.Run("grandchild", func(t *testing.T) {
+					// act
+					got := testdata.MakeWith[Person](t, cfg)
+
+					// assert
+					assert.Equal(t, child, got.ID)
+					assert.Equal(t, state, got.State)
+				})
+
+				// assert
+				assert.NotEqual(t, id, child)
+				assert.Equal(t, child, testdata.MakeStickyWith[ID](t, cfg))
+			})
+
+			// act
+			got := testdata.MakeWith[Person](t, cfg)
+
+			// assert
+			assert.Equal(t, id, got.ID)
+		})
+
+		t.Run("keyed", func(t *testing.T) {
+			type UserID string
+			type Order struct {
+				BuyerID  UserID
+				SellerID *UserID
+				AgentID  UserID
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithStickyKey("Order.BuyerID", "buyer"),
+					testdata.WithStickyKey("SellerID", "seller"),
+				)
+				buyer  = testdata.MakeStickyAsWith[UserID](t, cfg, "buyer")
+				seller = testdata.MakeStickyAsWith[UserID](t, cfg, "seller")
+				agent  = testdata.MakeStickyWith[UserID](t, cfg)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.NotEqual(t, buyer, seller)
+			assert.NotEqual(t, buyer, agent)
+			assert.NotEqual(t, seller, agent)
+			assert.Equal(t, buyer, got.BuyerID)
+			if assert.NotNil(t, got.SellerID) {
+				assert.Equal(t, seller, *got.SellerID)
+			}
+			assert.Equal(t, agent, got.AgentID)
+			assert.Equal(t, buyer, testdata.MakeStickyAsWith[UserID](t, cfg, "buyer"))
+			assert.Equal(t, agent, testdata.MakeWith[UserID](t, cfg))
+
+			t.Run("subtest", func(t *testing.T) {
+				var child = testdata.MakeStickyAsWith[UserID](t, cfg, "seller")
+
+				// act
+				got := testdata.MakeWith[Order](t, cfg)
+
+				// assert
+				assert.NotEqual(t, seller, child)
+				assert.Equal(t, buyer, got.BuyerID)
+				if assert.NotNil(t, got.SellerID) {
+					assert.Equal(t, child, *got.SellerID)
+				}
+			})
+		})
+
+		t.Run("keyed embedded", func(t *testing.T) {
+			type UserID string
+			type Base struct {
+				BuyerID UserID
+			}
+			type Order struct {
+				Base
+				AgentID UserID
+			}
+			var (
+				cfg   = testdata.NewConfig(testdata.WithStickyKey("Base.BuyerID", "buyer"))
+				buyer = testdata.MakeStickyAsWith[UserID](t, cfg, "buyer")
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, buyer, got.BuyerID)
+			assert.NotEqual(t, buyer, got.AgentID)
+		})
+
+		t.Run("keyed without value", func(t *testing.T) {
+			type UserID string
+			type Order struct {
+				BuyerID UserID
+			}
+			var (
+				cfg = testdata.NewConfig(testdata.WithStickyKey("BuyerID", "buyer"))
+				id  = testdata.MakeStickyWith[UserID](t, cfg)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, id, got.BuyerID)
+			assert.Panic(t, func() { testdata.WithStickyKey("BuyerID", "") })
+		})
+
 		t.Run("sticky pointer value", func(t *testing.T) {
 			var (
 				cfg = testdata.NewConfig()
@@ -233,6 +374,49 @@ func TestMake(t *testing.T) {
 			// assert
 			assert.Equal(t, 4969059760275911952, got)
 		})
+
+		t.Run("Seed", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Data struct {
+				Name string
+				Age  int
+			}
+			var (
+				cfgA = testdata.NewConfig(testdata.WithSeed(42))
+				cfgB = testdata.NewConfig(testdata.WithSeed(42))
+			)
+
+			t.Run("other test", func(t *testing.T) {
+				_ = testdata.MakeWith[[]Data](t, cfgB)
+			})
+
+			// act
+			a := testdata.MakeWith[Data](t, cfgA)
+			b := testdata.MakeWith[Data](t, cfgB)
+
+			// assert
+			assert.Equal(t, a, b)
+		})
+
+		t.Run("Seed per test", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg     = testdata.NewConfig(testdata.WithSeed(42))
+				results = make(map[string]int)
+			)
+
+			for _, name := range []string{"a", "b"} {
+				t.Run(name, func(t *testing.T) {
+					// act
+					results[name] = testdata.MakeWith[int](t, cfg)
+				})
+			}
+
+			// assert
+			assert.NotEqual(t, results["a"], results["b"])
+		})
 	})
 
 	t.Run("Modifications", func(t *testing.T) {
@@ -722,6 +906,157 @@ func TestMake(t *testing.T) {
 			assert.TimeWithinWindow(t, time.Now(), time.Time(got), fourYears)
 		})
 
+		t.Run("clock and window", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				now = time.Date(2024, time.February, 28, 12, 0, 0, 0, time.UTC)
+				cfg = testdata.NewConfig(
+					testdata.WithSeed(7),
+					testdata.WithClock(func() time.Time { return now }),
+					testdata.WithTimeWindow(48*time.Hour, 24*time.Hour),
+				)
+				past = false
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[time.Time](t, cfg)
+
+				// assert
+				assert.True(t, !got.Before(now.Add(-48*time.Hour)) && !got.After(now.Add(24*time.Hour)))
+				past = past || got.Before(now)
+			}
+			assert.True(t, past)
+		})
+
+		t.Run("reproducible", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				now      = time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+				generate = func() time.Time {
+					var cfg = testdata.NewConfig(
+						testdata.WithSeed(42),
+						testdata.WithClock(func() time.Time { return now }),
+					)
+					return testdata.MakeWith[time.Time](t, cfg)
+				}
+			)
+
+			// act
+			got := generate()
+
+			// assert
+			assert.True(t, got.Equal(generate()))
+		})
+
+		t.Run("precision", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				tokyo = time.FixedZone("JST", 9*60*60)
+				sec   = testdata.NewConfig(testdata.WithTimePrecision(time.Second))
+				milli = testdata.NewConfig(testdata.WithTimePrecision(time.Millisecond))
+				days  = testdata.NewConfig(testdata.WithTimePrecision(24*time.Hour), testdata.WithTimeLocations(tokyo))
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				gotSec := testdata.MakeWith[time.Time](t, sec)
+				gotMilli := testdata.MakeWith[time.Time](t, milli)
+				gotDay := testdata.MakeWith[time.Time](t, days)
+
+				// assert
+				assert.Equal(t, 0, gotSec.Nanosecond())
+				assert.Equal(t, 0, gotMilli.Nanosecond()%int(time.Millisecond))
+				assert.Equal(t, tokyo, gotDay.Location())
+				assert.Equal(t, "00:00:00", gotDay.Format(time.TimeOnly))
+			}
+		})
+
+		t.Run("locations", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				east  = time.FixedZone("East", 2*60*60)
+				west  = time.FixedZone("West", -5*60*60)
+				cfg   = testdata.NewConfig(testdata.WithTimeLocations(east, west))
+				names = make(map[string]bool)
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[time.Time](t, cfg)
+
+				// assert
+				names[got.Location().String()] = true
+			}
+			assert.Equal(t, 2, len(names))
+			assert.True(t, names["East"] && names["West"])
+			assert.Panic(t, func() { testdata.WithTimeLocations() })
+			assert.Panic(t, func() { testdata.WithTimeWindow(-time.Hour, 0) })
+		})
+	})
+
+	t.Run("time.Duration and time.Location", func(t *testing.T) {
+		t.Parallel()
+		type Job struct {
+			Timeout  time.Duration
+			Interval *time.Duration
+			Location *time.Location
+		}
+
+		t.Run("default", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg   = testdata.NewConfig()
+				zones = make(map[string]bool)
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Job](t, cfg)
+
+				// assert
+				assert.True(t, got.Timeout >= 0 && got.Timeout <= 24*time.Hour)
+				assert.Equal(t, time.Duration(0), got.Timeout%time.Millisecond)
+				if assert.NotNil(t, got.Interval) {
+					assert.True(t, *got.Interval >= 0 && *got.Interval <= 24*time.Hour)
+				}
+				if assert.NotNil(t, got.Location) {
+					_, err := time.LoadLocation(got.Location.String())
+					assert.NoError(t, err)
+					zones[got.Location.String()] = true
+				}
+			}
+			assert.True(t, len(zones) > 50)
+		})
+
+		t.Run("range and unit", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithDurationRange(-90*time.Second, 90*time.Second),
+					testdata.WithDurationUnit(time.Minute),
+				)
+				got = make(map[time.Duration]bool)
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				d := testdata.MakeWith[time.Duration](t, cfg)
+
+				// assert
+				got[d] = true
+			}
+			assert.Equal(t, 3, len(got))
+			assert.True(t, got[-time.Minute] && got[0] && got[time.Minute])
+			assert.Panic(t, func() { testdata.WithDurationRange(time.Hour, time.Minute) })
+			assert.Panic(t, func() { testdata.WithDurationUnit(0) })
+		})
 	})
 
 	t.Run("simple/types", func(t *testing.T) {
@@ -827,4 +1162,1774 @@ func TestMake(t *testing.T) {
 		})
 	})
 
+	t.Run("struct tags", func(t *testing.T) {
+		t.Parallel()
+		type Name string
+		type Level int
+
+		t.Run("min max", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Age     int     `testdata:"min=1,max=99"`
+				Level   Level   `testdata:"min=-3,max=3"`
+				Count   uint8   `testdata:"max=10"`
+				Price   float64 `testdata:"min=10,max=20"`
+				Names   []Name  `testdata:"min=1,max=3"`
+				Pointer *int    `testdata:"min=5,max=5"`
+			}
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Data](t, cfg)
+
+				// assert
+				assert.True(t, got.Age >= 1 && got.Age <= 99)
+				assert.True(t, got.Level >= -3 && got.Level <= 3)
+				assert.True(t, got.Count <= 10)
+				assert.True(t, got.Price >= 10 && got.Price <= 20)
+				assert.True(t, len(got.Names) >= 1 && len(got.Names) <= 3)
+				if assert.NotNil(t, got.Pointer) {
+					assert.Equal(t, 5, *got.Pointer)
+				}
+			}
+		})
+
+		t.Run("len", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Code  Name           `testdata:"len=8"`
+				Names []Name         `testdata:"len=2"`
+				Index map[string]int `testdata:"len=3"`
+			}
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.Match(t, "^[a-zA-Z0-9]{8}$", got.Code)
+			assert.Equal(t, 2, len(got.Names))
+			assert.Equal(t, 3, len(got.Index))
+		})
+
+		t.Run("oneof", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Name  Name  `testdata:"oneof=a|b|c"`
+				Level Level `testdata:"oneof=1|2"`
+			}
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.OneOf(t, []Name{"a", "b", "c"}, got.Name)
+			assert.OneOf(t, []Level{1, 2}, got.Level)
+		})
+
+		t.Run("zero and skip", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Zero    Name  `testdata:"zero"`
+				Skip    *Name `testdata:"skip"`
+				Regular Name
+			}
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.Equal(t, "", got.Zero)
+			assert.Equal(t, nil, got.Skip)
+			assert.Match(t, "^Name-[a-zA-Z0-9]{16}$", got.Regular)
+		})
+
+		t.Run("zero and skip embedded", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Audit struct {
+				CreatedBy Name
+			}
+			type Version struct {
+				Number int
+			}
+			type Data struct {
+				*Audit   `testdata:"zero"`
+				*Version `testdata:"skip"`
+				Regular  Name
+			}
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.True(t, got.Audit == nil)
+			assert.True(t, got.Version == nil)
+			assert.Match(t, "^Name-[a-zA-Z0-9]{16}$", got.Regular)
+		})
+
+		t.Run("nonzero", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Flag  bool  `testdata:"nonzero"`
+				Level Level `testdata:"nonzero,min=0,max=1"`
+			}
+
+			for i := 0; i < 20; i++ {
+				// act
+				got := testdata.MakeWith[Data](t, cfg)
+
+				// assert
+				assert.Equal(t, true, got.Flag)
+				assert.Equal(t, 1, got.Level)
+			}
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig()
+				fake = &fakeT{name: t.Name()}
+			)
+
+			type Data struct {
+				Age int `testdata:"min=10,max=1,nonzero"`
+			}
+
+			// act
+			got := testdata.MakeWith[Data](fake, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, "testdata: field Age: min 10 is larger than max 1", fake.errors[0])
+			}
+			assert.Equal(t, 0, got.Age)
+		})
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		t.Parallel()
+		type OrderID string
+		type Code string
+
+		t.Run("option", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithPattern[OrderID](`^[A-Z]{3}-\d{6}$`),
+					testdata.WithPattern[Code](`(ab|cd)+x*[^a-z]?\.(?i:ok)`),
+				)
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[OrderID](t, cfg)
+				code := testdata.MakeWith[Code](t, cfg)
+
+				// assert
+				assert.Match(t, `^[A-Z]{3}-\d{6}$`, got)
+				assert.Match(t, `^(ab|cd){1,9}x{0,8}[^a-z]?\.(?i:ok)$`, code)
+			}
+		})
+
+		t.Run("tag", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				ID      OrderID `testdata:"pattern=^[A-Z]{3}-\\d{6}$"`
+				Version *string `testdata:"nonzero,pattern=v\\d{1,2}(\\.\\d{1,2}){0,2}"`
+			}
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Data](t, cfg)
+
+				// assert
+				assert.Match(t, `^[A-Z]{3}-\d{6}$`, got.ID)
+				if assert.NotNil(t, got.Version) {
+					assert.Match(t, `^v\d{1,2}(\.\d{1,2}){0,2}$`, *got.Version)
+				}
+			}
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Combined struct {
+				ID string `testdata:"len=3,pattern=[a-z]+"`
+			}
+			type NotString struct {
+				ID int `testdata:"pattern=[0-9]+"`
+			}
+			type Empty struct {
+				ID string `testdata:"pattern=[^\\x00-\\x{10FFFF}]"`
+			}
+
+			var (
+				cfg  = testdata.NewConfig()
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			option := assert.Panic(t, func() { testdata.WithPattern[Code]("[a-z") })
+			_ = testdata.MakeWith[Combined](fake, cfg)
+			_ = testdata.MakeWith[NotString](fake, cfg)
+			_ = testdata.MakeWith[Empty](fake, cfg)
+			empty := assert.Panic(t, func() { testdata.WithPattern[Code](`a[^\x00-\x{10FFFF}]+`) })
+			optional := testdata.MakeWith[Code](t, testdata.NewConfig(testdata.WithPattern[Code](`a[^\x00-\x{10FFFF}]*`)))
+
+			// assert
+			assert.Equal(t, "testdata: invalid pattern \"[a-z\": error parsing regexp: missing closing ]: `[a-z`", option)
+			if assert.Equal(t, 3, len(fake.errors)) {
+				assert.Equal(t, `testdata: field ID: invalid testdata tag "len=3,pattern=[a-z]+": pattern cannot be combined with min, max, len or oneof`, fake.errors[0])
+				assert.Equal(t, "testdata: field ID: pattern is not supported for type int", fake.errors[1])
+				assert.Equal(t, `testdata: field ID: empty character class [^\x00-\x{10FFFF}] matches no string`, fake.errors[2])
+			}
+			assert.Equal(t, `testdata: invalid pattern "a[^\\x00-\\x{10FFFF}]+": empty character class [^\x00-\x{10FFFF}] matches no string`, empty)
+			assert.Equal(t, "a", optional)
+		})
+	})
+
+	t.Run("recursive types", func(t *testing.T) {
+		t.Parallel()
+		type Node struct {
+			Name     string
+			Parent   *Node
+			Children []Node
+			Index    map[string]*Node
+		}
+
+		var depth func(node *Node) int
+		depth = func(node *Node) int {
+			if node == nil {
+				return 0
+			}
+			var deepest = depth(node.Parent)
+			for i := range node.Children {
+				deepest = max(deepest, depth(&node.Children[i]))
+			}
+			for _, child := range node.Index {
+				deepest = max(deepest, depth(child))
+			}
+			return deepest + 1
+		}
+
+		t.Run("default depth", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeWith[Node](t, cfg)
+
+			// assert
+			assert.Equal(t, 3, depth(&got))
+			assert.Equal(t, 5, len(got.Children))
+			assert.Equal(t, 5, len(got.Children[0].Children))
+			assert.Equal(t, 0, len(got.Children[0].Children[0].Children))
+			assert.Equal(t, nil, got.Children[0].Children[0].Parent)
+		})
+
+		t.Run("max depth", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(testdata.WithMaxDepth(5))
+			)
+
+			// act
+			got := testdata.MakeWith[*Node](t, cfg)
+
+			// assert
+			if assert.NotNil(t, got) {
+				assert.Equal(t, 5, depth(got))
+			}
+		})
+
+		t.Run("linked list", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Item struct {
+				Value int
+				Next  *Item `testdata:"nonzero"`
+			}
+			var (
+				cfg = testdata.NewConfig(testdata.WithMaxDepth(1))
+			)
+
+			// act
+			got := testdata.MakeWith[Item](t, cfg)
+
+			// assert
+			assert.Equal(t, nil, got.Next)
+		})
+
+		t.Run("func and chan", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type FuncNode struct {
+				Name string
+				Next func() FuncNode
+			}
+			type ChanNode struct {
+				Name string
+				Next chan ChanNode
+			}
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			gotFunc := testdata.MakeWith[FuncNode](t, cfg)
+			gotChan := testdata.MakeWith[ChanNode](t, cfg)
+
+			// assert
+			var funcDepth = 0
+			for node := gotFunc; node.Next != nil; node = node.Next() {
+				funcDepth++
+			}
+			assert.Equal(t, 2, funcDepth)
+
+			var chanDepth = 0
+			for node := gotChan; node.Next != nil; node = <-node.Next {
+				chanDepth++
+			}
+			assert.Equal(t, 2, chanDepth)
+		})
+	})
+
+	t.Run("other kinds", func(t *testing.T) {
+		t.Parallel()
+		type ID [16]byte
+		type Events chan string
+		type Types struct {
+			ID         ID
+			Hashes     [2][4]uint8
+			Complex64  complex64
+			Complex128 complex128
+			Uintptr    uintptr
+			Events     Events
+			Receive    <-chan int
+			Send       chan<- int
+			Func       func(a int) (string, int)
+		}
+
+		t.Run("make", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeWith[Types](t, cfg)
+
+			// assert
+			assert.NotZero(t, got.ID)
+			assert.NotZero(t, got.Hashes[0])
+			assert.NotZero(t, got.Hashes[1])
+			assert.NotZero(t, got.Complex64)
+			assert.NotZero(t, got.Complex128)
+			assert.NotZero(t, got.Uintptr)
+			if assert.NotNil(t, got.Events) {
+				assert.Equal(t, 5, len(got.Events))
+				assert.Match(t, "^string-[a-zA-Z0-9]{16}$", <-got.Events)
+			}
+			if assert.NotNil(t, got.Receive) {
+				assert.Equal(t, 5, len(got.Receive))
+			}
+			if assert.NotNil(t, got.Send) {
+				assert.Equal(t, 5, len(got.Send))
+			}
+			if assert.NotNil(t, got.Func) {
+				s, i := got.Func(1)
+				assert.Match(t, "^string-[a-zA-Z0-9]{16}$", s)
+				assert.NotZero(t, i)
+			}
+		})
+
+		t.Run("func returns same results", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+				fn  = testdata.MakeWith[func() string](t, cfg)
+			)
+
+			// act
+			got := fn()
+
+			// assert
+			assert.Equal(t, fn(), got)
+		})
+	})
+
+	t.Run("interface", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("implementations", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithImplementations[fmt.Stringer](Currency(""), &Amount{}),
+				)
+				seen = make(map[string]bool)
+			)
+
+			type Payment struct {
+				Method fmt.Stringer
+			}
+
+			for i := 0; i < 50; i++ {
+				// act
+				got := testdata.MakeWith[Payment](t, cfg)
+
+				// assert
+				switch method := got.Method.(type) {
+				case Currency:
+					assert.Match(t, "^Currency-[a-zA-Z0-9]{16}$", method)
+				case *Amount:
+					if assert.NotNil(t, method) {
+						assert.NotZero(t, method.Value)
+					}
+				default:
+					t.Fatalf("unexpected implementation %T", got.Method)
+				}
+				seen[fmt.Sprintf("%T", got.Method)] = true
+			}
+			assert.Equal(t, 2, len(seen))
+		})
+
+		t.Run("no implementations", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			type Data struct {
+				Any   any
+				Error error
+			}
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.Equal(t, nil, got.Any)
+			assert.Equal(t, nil, got.Error)
+		})
+
+		t.Run("recursive", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithImplementations[Expr](Sum{}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Expr](t, cfg)
+
+			// assert
+			var depth = 0
+			for expr := got; expr != nil; expr = expr.(Sum).Left {
+				depth++
+			}
+			assert.Equal(t, 3, depth)
+		})
+	})
+
+	t.Run("any json", func(t *testing.T) {
+		t.Parallel()
+		var depth func(t *testing.T, v any) int
+		depth = func(t *testing.T, v any) int {
+			var deepest = 0
+			switch v := v.(type) {
+			case nil, bool, float64, string:
+				return 1
+			case []any:
+				for _, e := range v {
+					deepest = max(deepest, depth(t, e))
+				}
+				assert.True(t, len(v) <= 4)
+			case map[string]any:
+				for _, e := range v {
+					deepest = max(deepest, depth(t, e))
+				}
+				assert.True(t, len(v) <= 4)
+			default:
+				t.Fatalf("unexpected JSON value %T", v)
+			}
+			return deepest + 1
+		}
+
+		t.Run("make", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(testdata.WithAnyJSON(3, 4))
+			)
+
+			type Payload struct {
+				Data    any
+				Details map[string]any
+			}
+
+			for i := 0; i < 50; i++ {
+				// act
+				got := testdata.MakeWith[Payload](t, cfg)
+
+				// assert
+				assert.True(t, depth(t, got.Data) <= 3)
+				assert.Equal(t, 5, len(got.Details))
+				for _, v := range got.Details {
+					assert.True(t, depth(t, v) <= 3)
+				}
+				_, err := json.Marshal(got)
+				assert.NoError(t, err)
+			}
+		})
+
+		t.Run("implementations take precedence", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithAnyJSON(3, 4),
+					testdata.WithImplementations[any](Currency("")),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[any](t, cfg)
+
+			// assert
+			_, ok := got.(Currency)
+			assert.True(t, ok)
+		})
+	})
+
+	t.Run("collection sizes", func(t *testing.T) {
+		t.Parallel()
+		type Item struct {
+			Name string
+		}
+		type Items []Item
+		type Order struct {
+			Items Items
+			Tags  []string
+			Notes map[string]string
+			Owner *Item
+		}
+
+		t.Run("slice len", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithSliceLen[Items](0, 20),
+					testdata.WithKindLen(reflect.Slice, 2, 2),
+				)
+				sizes = make(map[int]bool)
+			)
+
+			for i := 0; i < 200; i++ {
+				// act
+				got := testdata.MakeWith[Order](t, cfg)
+
+				// assert
+				assert.True(t, len(got.Items) <= 20)
+				assert.Equal(t, 2, len(got.Tags))
+				assert.Equal(t, 5, len(got.Notes))
+				sizes[len(got.Items)] = true
+			}
+			assert.True(t, sizes[0])
+			assert.True(t, len(sizes) > 10)
+		})
+
+		t.Run("map len", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithMapLen[map[string]string](1, 3),
+					testdata.WithKindLen(reflect.Map, 0, 0),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[map[string]string](t, cfg)
+			other := testdata.MakeWith[map[string]int](t, cfg)
+
+			// assert
+			assert.True(t, len(got) >= 1 && len(got) <= 3)
+			assert.Equal(t, 0, len(other))
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			t.Parallel()
+			// act
+			got := assert.Panic(t, func() {
+				testdata.WithSliceLen[map[string]int](0, 1)
+			})
+
+			// assert
+			assert.Equal(t, "testdata: map[string]int is not a slice", got)
+		})
+
+		t.Run("always nil", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(testdata.WithNilProbability(1))
+			)
+
+			// act
+			got := testdata.MakeWith[*Order](t, cfg)
+
+			// assert
+			if assert.NotNil(t, got) {
+				assert.Equal(t, nil, got.Owner)
+				assert.True(t, got.Items == nil)
+				assert.True(t, got.Tags == nil)
+				assert.True(t, got.Notes == nil)
+			}
+		})
+
+		t.Run("sometimes nil", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg     = testdata.NewConfig(testdata.WithNilProbability(0.5))
+				nils    = 0
+				notNils = 0
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Order](t, cfg)
+
+				// assert
+				if got.Owner == nil {
+					nils++
+				} else {
+					notNils++
+				}
+			}
+			assert.True(t, nils > 0)
+			assert.True(t, notNils > 0)
+		})
+
+		t.Run("embedded pointers", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Base struct {
+				ID string
+			}
+			type Order struct {
+				*Base
+				Number int
+			}
+			var (
+				cfg = testdata.NewConfig(testdata.WithNilProbability(1))
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.True(t, got.Base == nil)
+			assert.NotZero(t, got.Number)
+		})
+
+		t.Run("interfaces", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Payment struct {
+				Method fmt.Stringer
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithNilProbability(0.5),
+					testdata.WithImplementations[fmt.Stringer](&Amount{}),
+				)
+				nils    = 0
+				notNils = 0
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Payment](t, cfg)
+
+				// assert
+				if got.Method == nil {
+					nils++
+					continue
+				}
+				notNils++
+				assert.True(t, got.Method.(*Amount) != nil)
+			}
+			assert.True(t, nils > 0)
+			assert.True(t, notNils > 0)
+		})
+
+		t.Run("after interfaces with a generator", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Payment struct {
+				Method fmt.Stringer
+				Note   *string
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithNilProbability(0.5),
+					testdata.WithImplementations[fmt.Stringer](Currency("")),
+					testdata.WithGenerator(func(r *rand.Rand) Currency {
+						return "DKK"
+					}),
+				)
+				nils = 0
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Payment](t, cfg)
+
+				// assert
+				if got.Method != nil && got.Note == nil {
+					nils++
+				}
+			}
+			assert.True(t, nils > 0)
+		})
+	})
+
+	t.Run("numbers", func(t *testing.T) {
+		t.Parallel()
+		type Level int8
+		type Numbers struct {
+			Int8    int8
+			Int16   int16
+			Int     int
+			Uint8   uint8
+			Uint16  uint16
+			Uint    uint
+			Level   Level
+			Float32 float32
+			Float64 float64
+		}
+
+		t.Run("default range", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg    = testdata.NewConfig()
+				int8s  = make(map[int8]bool)
+				uint8s = make(map[uint8]bool)
+			)
+
+			for i := 0; i < 5000; i++ {
+				// act
+				got := testdata.MakeWith[Numbers](t, cfg)
+
+				// assert
+				assert.True(t, got.Int8 >= 0 && got.Int16 >= 0 && got.Int >= 0)
+				assert.True(t, got.Float64 >= 0 && got.Float64 < 1)
+				int8s[got.Int8] = true
+				uint8s[got.Uint8] = true
+			}
+			assert.True(t, int8s[math.MaxInt8])
+			assert.True(t, uint8s[math.MaxUint8])
+		})
+
+		t.Run("full range", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg      = testdata.NewConfig(testdata.WithFullRange())
+				negative = make(map[string]bool)
+				large    = false
+				high     = false
+				kinds    = []string{"int8", "int16", "int", "level", "float"}
+			)
+
+			for i := 0; i < 1000; i++ {
+				// act
+				got := testdata.MakeWith[Numbers](t, cfg)
+
+				// assert
+				assert.True(t, !math.IsNaN(got.Float64) && !math.IsInf(got.Float64, 0))
+				assert.True(t, !math.IsNaN(float64(got.Float32)) && !math.IsInf(float64(got.Float32), 0))
+				negative["int8"] = negative["int8"] || got.Int8 < 0
+				negative["int16"] = negative["int16"] || got.Int16 < 0
+				negative["int"] = negative["int"] || got.Int < 0
+				negative["level"] = negative["level"] || got.Level < 0
+				negative["float"] = negative["float"] || got.Float64 < 0
+				large = large || math.Abs(got.Float64) > 1e100
+				high = high || got.Uint16 > math.MaxInt16
+			}
+			for _, kind := range kinds {
+				if !negative[kind] {
+					t.Errorf("no negative %s", kind)
+				}
+			}
+			assert.True(t, large)
+			assert.True(t, high)
+		})
+
+		t.Run("edge cases", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg   = testdata.NewConfig(testdata.WithEdgeCases(1))
+				int8s = make(map[int8]bool)
+				uints = make(map[uint]bool)
+				nan   = false
+				inf   = false
+				zero  = false
+			)
+
+			for i := 0; i < 500; i++ {
+				// act
+				got := testdata.MakeWith[Numbers](t, cfg)
+
+				// assert
+				int8s[got.Int8] = true
+				uints[got.Uint] = true
+				nan = nan || math.IsNaN(got.Float64)
+				inf = inf || math.IsInf(float64(got.Float32), -1)
+				zero = zero || (got.Float64 == 0 && math.Signbit(got.Float64))
+			}
+			assert.Equal(t, 7, len(int8s))
+			assert.True(t, int8s[math.MinInt8] && int8s[math.MaxInt8] && int8s[-1])
+			assert.Equal(t, 4, len(uints))
+			assert.True(t, uints[math.MaxUint])
+			assert.True(t, nan)
+			assert.True(t, inf)
+			assert.True(t, zero)
+		})
+
+		t.Run("edge case rate", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg   = testdata.NewConfig(testdata.WithEdgeCases(0.5))
+				edges = 0
+			)
+
+			for i := 0; i < 1000; i++ {
+				// act
+				got := testdata.MakeWith[int64](t, cfg)
+
+				// assert
+				if got == 0 || got == 1 || got == -1 || got <= math.MinInt64+1 || got >= math.MaxInt64-1 {
+					edges++
+				}
+			}
+			assert.True(t, edges > 400 && edges < 600)
+			assert.Panic(t, func() { testdata.WithEdgeCases(2) })
+		})
+		t.Run("range", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Age int
+			type Port uint16
+			type Person struct {
+				Age  Age
+				Port Port
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithRange[Age](18, 65),
+					testdata.WithRange[Port](65530, 65535),
+				)
+				ages = make(map[Age]bool)
+			)
+
+			for i := 0; i < 1000; i++ {
+				// act
+				got := testdata.MakeWith[Person](t, cfg)
+
+				// assert
+				assert.True(t, got.Age >= 18 && got.Age <= 65)
+				assert.True(t, got.Port >= 65530)
+				ages[got.Age] = true
+			}
+			assert.Equal(t, 48, len(ages))
+			assert.Panic(t, func() { testdata.WithRange[Age](65, 18) })
+		})
+
+		t.Run("float range", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Price float64
+			type Weight float32
+			type Item struct {
+				Price  Price
+				Weight Weight
+				Ratio  float64
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFloatRange[Price](0.01, 999.99, 2),
+					testdata.WithFloatRange[Weight](0.5, 0.7, 1),
+					testdata.WithFloatRange(-1.5, -1.0, -1),
+				)
+				weights = make(map[Weight]bool)
+			)
+
+			for i := 0; i < 1000; i++ {
+				// act
+				got := testdata.MakeWith[Item](t, cfg)
+
+				// assert
+				assert.True(t, got.Price >= 0.01 && got.Price <= 999.99)
+				assert.Equal(t, math.Round(float64(got.Price)*100)/100, float64(got.Price))
+				assert.True(t, got.Ratio >= -1.5 && got.Ratio <= -1.0)
+				weights[got.Weight] = true
+			}
+			assert.Equal(t, 3, len(weights))
+			assert.True(t, weights[0.5] && weights[0.6] && weights[0.7])
+			assert.Panic(t, func() { testdata.WithFloatRange[Price](0.011, 0.019, 2) })
+			assert.Panic(t, func() { testdata.WithFloatRange[Price](1, Price(math.NaN()), 2) })
+		})
+	})
+
+	t.Run("string shape", func(t *testing.T) {
+		t.Parallel()
+		type Code string
+		type Token string
+		type Data struct {
+			Code  Code
+			Token Token
+			Name  string
+			Short string `testdata:"len=4"`
+		}
+
+		t.Run("length", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithStringLen[Code](2, 4),
+					testdata.WithKindLen(reflect.String, 8, 8),
+				)
+			)
+
+			for i := 0; i < 100; i++ {
+				// act
+				got := testdata.MakeWith[Data](t, cfg)
+
+				// assert
+				assert.Match(t, "^Code-[a-zA-Z0-9]{2,4}$", got.Code)
+				assert.Match(t, "^Token-[a-zA-Z0-9]{8}$", got.Token)
+				assert.Match(t, "^[a-zA-Z0-9]{4}$", got.Short)
+			}
+		})
+
+		t.Run("charsets", func(t *testing.T) {
+			t.Parallel()
+			var testCases = []struct {
+				name     string
+				charset  testdata.Charset
+				expected string
+			}{
+				{"alphanumeric", testdata.Alphanumeric, `^[a-zA-Z0-9]{16}$`},
+				{"letters", testdata.Letters, `^[a-zA-Z]{16}$`},
+				{"digits", testdata.Digits, `^[0-9]{16}$`},
+				{"hex", testdata.Hex, `^[0-9a-f]{16}$`},
+				{"base64", testdata.Base64, `^[A-Za-z0-9+/]{16}$`},
+				{"printable", testdata.Printable, `^[ -~]{16}$`},
+				{"unicode", testdata.Unicode, `^[\p{L}\p{M}\p{N}\p{P}\p{S}\p{Zs}]{16}$`},
+				{"emoji", testdata.Emoji, `^\p{So}{16}$`},
+				{"rtl", testdata.RTL, `^[\p{Hebrew}\p{Arabic}]{16}$`},
+				{"combining", testdata.Combining, `^([a-zA-Z]\p{Mn}{1,2}){16}$`},
+			}
+
+			for _, tc := range testCases {
+				t.Run(tc.name, func(t *testing.T) {
+					t.Parallel()
+					// arrange
+					var (
+						cfg = testdata.NewConfig(
+							testdata.WithStringCharset(tc.charset),
+							testdata.WithStringPrefix(""),
+						)
+					)
+
+					for i := 0; i < 20; i++ {
+						// act
+						got := testdata.MakeWith[string](t, cfg)
+
+						// assert
+						assert.Match(t, tc.expected, got)
+						assert.True(t, utf8.ValidString(got))
+					}
+				})
+			}
+		})
+
+		t.Run("charset of type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithStringCharset(testdata.Digits),
+					testdata.WithCharsetOf[Token](testdata.Hex),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.Match(t, "^Code-[0-9]{16}$", got.Code)
+			assert.Match(t, "^Token-[0-9a-f]{16}$", got.Token)
+			assert.Match(t, "^[0-9]{4}$", got.Short)
+		})
+
+		t.Run("prefix", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithStringPrefix("{type}_"),
+					testdata.WithPrefixOf[Code](""),
+					testdata.WithPrefixOf[Token]("tok_{type}:"),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Data](t, cfg)
+
+			// assert
+			assert.Match(t, "^[a-zA-Z0-9]{16}$", got.Code)
+			assert.Match(t, "^tok_Token:[a-zA-Z0-9]{16}$", got.Token)
+			assert.Match(t, "^string_[a-zA-Z0-9]{16}$", got.Name)
+		})
+	})
+
+	t.Run("map keys", func(t *testing.T) {
+		t.Parallel()
+		type Color string
+
+		t.Run("unique keys", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(testdata.WithKindLen(reflect.Map, 50, 50))
+			)
+
+			// act
+			got := testdata.MakeWith[map[uint8]bool](t, cfg)
+
+			// assert
+			assert.Equal(t, 50, len(got))
+		})
+
+		t.Run("bool keys", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeWith[map[bool]string](t, cfg)
+
+			// assert
+			assert.Equal(t, 2, len(got))
+		})
+
+		t.Run("values keys", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithValues([]Color{"red", "green", "blue", "red"}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[map[Color]int](t, cfg)
+
+			// assert
+			assert.Equal(t, 3, len(got))
+		})
+
+		t.Run("values not comparable", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Label struct {
+				Value any
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithValues([]Label{{Value: "a"}, {Value: []string{"b"}}}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[[]Label](t, cfg)
+
+			// assert
+			assert.NotZero(t, len(got))
+		})
+
+		t.Run("sticky keys", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg   = testdata.NewConfig()
+				color = testdata.MakeStickyWith[Color](t, cfg)
+			)
+
+			// act
+			got := testdata.MakeWith[map[Color]int](t, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(got)) {
+				_, ok := got[color]
+				assert.True(t, ok)
+			}
+		})
+
+		t.Run("impossible size", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig(testdata.WithMapLen[map[bool]int](3, 3))
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			got := testdata.MakeWith[map[bool]int](fake, cfg)
+
+			// assert
+			assert.Equal(t, 2, len(got))
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, "testdata: cannot generate map[bool]int with 3 unique keys, got 2", fake.errors[0])
+			}
+		})
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		t.Parallel()
+		type Customer struct {
+			Name    string
+			Payment fmt.Stringer
+		}
+		type Order struct {
+			ID       string
+			Customer Customer
+		}
+
+		t.Run("interface without implementations", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig(testdata.WithStrict())
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith[Order](fake, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, "testdata: cannot generate Order.Customer.Payment of type fmt.Stringer: no implementations registered", fake.errors[0])
+			}
+		})
+
+		t.Run("pointer to pointer", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig(testdata.WithStrict())
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith[**int](fake, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, "testdata: cannot generate **int of type *int: pointers to pointers are not supported", fake.errors[0])
+			}
+		})
+
+		t.Run("unsafe pointer", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig(testdata.WithStrict())
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith[unsafe.Pointer](fake, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, "testdata: cannot generate Pointer of type unsafe.Pointer: unsafe pointers are not supported", fake.errors[0])
+			}
+		})
+
+		t.Run("generatable", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithStrict(),
+					testdata.WithImplementations[fmt.Stringer](Currency("")),
+				)
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			got := testdata.MakeWith[Order](fake, cfg)
+
+			// assert
+			assert.Equal(t, 0, len(fake.errors))
+			assert.NotZero(t, got.Customer.Payment)
+		})
+
+		t.Run("not strict", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig()
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			got := testdata.MakeWith[Order](fake, cfg)
+
+			// assert
+			assert.Equal(t, 0, len(fake.errors))
+			assert.Equal(t, nil, got.Customer.Payment)
+		})
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Parallel()
+		type City string
+		type Address struct {
+			Street string
+			City   City
+		}
+		type Customer struct {
+			Name    string
+			Address *Address
+		}
+		type Order struct {
+			ID       string
+			Customer Customer
+		}
+
+		t.Run("nested field", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeWith(t, cfg,
+				testdata.Set[Order]("Customer.Address.City", City("Oslo")),
+				testdata.Set[Order]("ID", "my id"),
+			)
+
+			// assert
+			assert.Equal(t, "my id", got.ID)
+			if assert.NotNil(t, got.Customer.Address) {
+				assert.Equal(t, "Oslo", got.Customer.Address.City)
+				assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Customer.Address.Street)
+			}
+		})
+
+		t.Run("nil pointer", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeWith(t, cfg,
+				testdata.Set[*Order]("Customer.Address", nil),
+				testdata.Set[*Order]("Customer.Address.City", "Oslo"),
+			)
+
+			// assert
+			if assert.NotNil(t, got.Customer.Address) {
+				assert.Equal(t, "Oslo", got.Customer.Address.City)
+				assert.Equal(t, "", got.Customer.Address.Street)
+			}
+		})
+
+		t.Run("generated parents", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(testdata.WithNilProbability(1))
+			)
+
+			// act
+			got := testdata.MakeWith(t, cfg,
+				testdata.Set[Order]("Customer.Address.City", City("Oslo")),
+			)
+
+			// assert
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Customer.Name)
+			if assert.NotNil(t, got.Customer.Address) {
+				assert.Equal(t, "Oslo", got.Customer.Address.City)
+				assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Customer.Address.Street)
+			}
+		})
+
+		t.Run("sticky", func(t *testing.T) {
+			// arrange
+			var (
+				cfg = testdata.NewConfig()
+			)
+
+			// act
+			got := testdata.MakeStickyWith(t, cfg, testdata.Set[Customer]("Name", "Alice"))
+
+			// assert
+			assert.Equal(t, "Alice", got.Name)
+			assert.Equal(t, "Alice", testdata.MakeWith[Order](t, cfg).Customer.Name)
+		})
+
+		t.Run("unknown path", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig()
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith(fake, cfg, testdata.Set[Order]("Customer.Adress.City", "Oslo"))
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Match(t, `^testdata: cannot set "Customer.Adress.City" on testdata_test.Order: unknown field Adress on testdata_test.Customer$`, fake.errors[0])
+			}
+		})
+
+		t.Run("wrong type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg  = testdata.NewConfig()
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith(fake, cfg, testdata.Set[Order]("Customer.Address.City", 42))
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Match(t, `^testdata: cannot set "Customer.Address.City" on testdata_test.Order: cannot use int as testdata_test.City$`, fake.errors[0])
+			}
+		})
+	})
+
+	t.Run("field generator", func(t *testing.T) {
+		t.Parallel()
+		type Email string
+		type User struct {
+			ID    string
+			Email Email
+			Phone *string `json:"phone_number"`
+			Name  string
+		}
+		type Order struct {
+			ID     string
+			Buyer  User
+			Seller User
+		}
+
+		t.Run("by name", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("Email", func(r *rand.Rand) string {
+						return fmt.Sprintf("user%d@example.com", r.IntN(100))
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Match(t, `^user\d+@example.com$`, got.Buyer.Email)
+			assert.Match(t, `^user\d+@example.com$`, got.Seller.Email)
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Buyer.Name)
+		})
+
+		t.Run("sticky type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("Email", func(r *rand.Rand) Email {
+						return "generated@example.com"
+					}),
+				)
+				email = testdata.MakeStickyWith[Email](t, cfg)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, email, got.Buyer.Email)
+			assert.Equal(t, email, got.Seller.Email)
+		})
+
+		t.Run("by path", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("ID", func(r *rand.Rand) string {
+						return "any id"
+					}),
+					testdata.WithFieldGenerator("User.ID", func(r *rand.Rand) string {
+						return "user id"
+					}),
+					testdata.WithFieldGenerator("Seller.ID", func(r *rand.Rand) string {
+						return "seller id"
+					}),
+					testdata.WithFieldGenerator("Order.Buyer.Name", func(r *rand.Rand) string {
+						return "buyer name"
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, "any id", got.ID)
+			assert.Equal(t, "user id", got.Buyer.ID)
+			assert.Equal(t, "seller id", got.Seller.ID)
+			assert.Equal(t, "buyer name", got.Buyer.Name)
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Seller.Name)
+		})
+
+		t.Run("embedded", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			type Base struct {
+				ID      string
+				Created string
+			}
+			type Invoice struct {
+				Base
+				Number string
+			}
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("Base.ID", func(r *rand.Rand) string {
+						return "base id"
+					}),
+					testdata.WithFieldGenFunc("Created", func(g *testdata.Gen) string {
+						return g.Path()
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Invoice](t, cfg)
+
+			// assert
+			assert.Equal(t, "base id", got.ID)
+			assert.Equal(t, "Invoice.Base.Created", got.Created)
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Number)
+		})
+
+		t.Run("by tag", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("json:phone_number", func(r *rand.Rand) string {
+						return "+45 12345678"
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[User](t, cfg)
+
+			// assert
+			if assert.NotNil(t, got.Phone) {
+				assert.Equal(t, "+45 12345678", *got.Phone)
+			}
+		})
+
+		t.Run("other type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("Name", func(r *rand.Rand) int {
+						return 42
+					}),
+				)
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			got := testdata.MakeWith[User](fake, cfg)
+
+			// assert
+			assert.Equal(t, 0, len(fake.errors))
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Name)
+		})
+
+		t.Run("wrong type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenerator("Name", func(r *rand.Rand) any {
+						return 42
+					}),
+				)
+				fake = &fakeT{name: t.Name()}
+			)
+
+			// act
+			_ = testdata.MakeWith[User](fake, cfg)
+
+			// assert
+			if assert.Equal(t, 1, len(fake.errors)) {
+				assert.Equal(t, `testdata: field generator "Name" returns int, which cannot be used for User.Name of type string`, fake.errors[0])
+			}
+		})
+	})
+
+	t.Run("gen func", func(t *testing.T) {
+		t.Parallel()
+		type CustomerID string
+		type Customer struct {
+			ID   CustomerID
+			Name string
+		}
+		type Order struct {
+			ID       string
+			Customer Customer
+			Status   string
+		}
+		type Invoice struct {
+			Order Order
+			Note  string
+		}
+
+		t.Run("make from", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithGenFunc(func(g *testdata.Gen) Order {
+						return Order{
+							ID:       "order",
+							Customer: testdata.MakeFrom[Customer](g),
+							Status:   "open",
+						}
+					}),
+					testdata.WithGenerator(func(r *rand.Rand) CustomerID {
+						return "customer"
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Invoice](t, cfg)
+
+			// assert
+			assert.Equal(t, "order", got.Order.ID)
+			assert.Equal(t, "open", got.Order.Status)
+			assert.Equal(t, "customer", got.Order.Customer.ID)
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.Order.Customer.Name)
+		})
+
+		t.Run("sticky", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithGenFunc(func(g *testdata.Gen) Order {
+						return Order{Customer: testdata.MakeFrom[Customer](g)}
+					}),
+				)
+				id = testdata.MakeStickyWith[CustomerID](t, cfg)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, id, got.Customer.ID)
+		})
+
+		t.Run("own type", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithGenFunc(func(g *testdata.Gen) Order {
+						var order = testdata.MakeFrom[Order](g)
+						order.Status = "open"
+						return order
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Order](t, cfg)
+
+			// assert
+			assert.Equal(t, "open", got.Status)
+			assert.Match(t, "^string-[a-zA-Z0-9]{16}$", got.ID)
+			assert.Match(t, "^CustomerID-[a-zA-Z0-9]{16}$", got.Customer.ID)
+		})
+
+		t.Run("path and parent", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithGenFunc(func(g *testdata.Gen) string {
+						if g.Parent() == nil {
+							return g.Path()
+						}
+						return g.Path() + " in " + g.Parent().Name()
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Invoice](t, cfg)
+			root := testdata.MakeWith[string](t, cfg)
+
+			// assert
+			assert.Equal(t, "Invoice.Note in Invoice", got.Note)
+			assert.Equal(t, "Invoice.Order.ID in Order", got.Order.ID)
+			assert.Equal(t, "Invoice.Order.Customer.Name in Customer", got.Order.Customer.Name)
+			assert.Equal(t, "string", root)
+		})
+
+		t.Run("locale", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				locale = func(g *testdata.Gen) string { return g.Locale() }
+				cfg    = testdata.NewConfig(testdata.WithGenFunc(locale))
+				da     = testdata.NewConfig(testdata.WithGenFunc(locale), testdata.WithLocale("da_DK"))
+			)
+
+			// act
+			got := testdata.MakeWith[string](t, cfg)
+			gotDa := testdata.MakeWith[string](t, da)
+
+			// assert
+			assert.Equal(t, "en_US", got)
+			assert.Equal(t, "da_DK", gotDa)
+			assert.Panic(t, func() { testdata.WithLocale("danish") })
+		})
+
+		t.Run("field gen func", func(t *testing.T) {
+			t.Parallel()
+			// arrange
+			var (
+				cfg = testdata.NewConfig(
+					testdata.WithFieldGenFunc("Name", func(g *testdata.Gen) string {
+						return fmt.Sprintf("%s %d", g.Parent().Name(), g.Rand().IntN(10))
+					}),
+				)
+			)
+
+			// act
+			got := testdata.MakeWith[Invoice](t, cfg)
+
+			// assert
+			assert.Match(t, `^Customer \d$`, got.Order.Customer.Name)
+		})
+	})
+
+}
+
+type Currency string
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+type Amount struct {
+	Value    int
+	Currency Currency
+}
+
+func (a *Amount) String() string {
+	return fmt.Sprintf("%d %s", a.Value, a.Currency)
+}
+
+type Expr interface {
+	Eval() int
+}
+
+type Sum struct {
+	Left  Expr
+	Right Expr
+}
+
+func (s Sum) Eval() int {
+	return s.Left.Eval() + s.Right.Eval()
 }
@@ -0,0 +1,57 @@
+package testdata
+
+import (
+	"reflect"
+
+	"github.com/kyuff/testdata/internal/generate"
+)
+
+// generateMap generates a map of typ with size unique keys. The size is capped
+// at the number of distinct keys when it is known. If a requested size cannot
+// be reached, it is reported as an error on the test.
+func (cfg *Config) generateMap(g *Gen, typ reflect.Type, size int, requested bool) reflect.Value {
+	var target = size
+	if n, ok := cfg.cardinality(g, typ.Key()); ok && n < target {
+		target = n
+	}
+
+	var theMap = generate.Map(typ, func(typ reflect.Type) reflect.Value {
+		return cfg.make(g, typ)
+	}, target)
+
+	if requested && theMap.Len() < size {
+		errorf(g.t, "testdata: cannot generate %s with %d unique keys, got %d", typ, size, theMap.Len())
+	}
+
+	return theMap
+}
+
+// cardinality returns the number of distinct values of typ, when it is known.
+func (cfg *Config) cardinality(g *Gen, typ reflect.Type) (int, bool) {
+	if _, ok := cfg.sticky.HasValue(g.t, typ, ""); ok {
+		return 1, true
+	}
+
+	if n, ok := cfg.domains[typ]; ok {
+		return n, true
+	}
+
+	if _, ok := cfg.rules[typ]; ok {
+		return 0, false
+	}
+
+	if typ.Size() == 0 {
+		return 1, true
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return 2, true
+	case reflect.Int8, reflect.Uint8:
+		return 1 << 8, true
+	case reflect.Int16, reflect.Uint16:
+		return 1 << 16, true
+	default:
+		return 0, false
+	}
+}
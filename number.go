@@ -0,0 +1,35 @@
+package testdata
+
+import (
+	"reflect"
+
+	"github.com/kyuff/testdata/internal/generate"
+)
+
+// generateNumber of typ when WithFullRange or WithEdgeCases applies to it.
+func (cfg *Config) generateNumber(g *Gen, typ reflect.Type) (reflect.Value, bool) {
+	if !isNumber(typ.Kind()) {
+		return reflect.Value{}, false
+	}
+
+	if cfg.edgeCases > 0 && g.rand.Float64() < cfg.edgeCases {
+		return generate.Edge(g.rand, typ), true
+	}
+
+	if cfg.fullRange {
+		return generate.FullRange(g.rand, typ), true
+	}
+
+	return reflect.Value{}, false
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,8 +1,15 @@
 package testdata
 
 import (
+	"fmt"
+	"math"
 	"math/rand/v2"
 	"reflect"
+	"regexp"
+	"time"
+
+	"github.com/kyuff/testdata/internal/generate"
+	"github.com/kyuff/testdata/internal/seed"
 )
 
 // Option to customize a Config.
@@ -17,15 +24,32 @@ func Generator[T any](generator func(r *rand.Rand) T) {
 // WithGenerator will override the default value generation and instead
 // use the supplied generator func for the Config.
 func WithGenerator[T any](generator func(r *rand.Rand) T) Option {
-	return func(cfg *Config) {
-		var (
-			t   T
-			typ = reflect.TypeOf(t)
-		)
+	return WithGenFunc(func(g *Gen) T {
+		return generator(g.Rand())
+	})
+}
 
-		cfg.rules[typ] = func(r *rand.Rand) reflect.Value {
-			return reflect.ValueOf(generator(r))
+// GenFunc will override the default value generation and instead
+// use the supplied generator func for DefaultConfig. See WithGenFunc.
+func GenFunc[T any](generator func(g *Gen) T) {
+	WithGenFunc(generator)(DefaultConfig)
+}
+
+// WithGenFunc will override the default value generation and instead
+// use the supplied generator func for the Config. The generator gets the
+// Gen of the value, so it can build composite values with MakeFrom
+// using the rest of the Config.
+//
+//	testdata.WithGenFunc(func(g *testdata.Gen) Order {
+//		return Order{Customer: testdata.MakeFrom[Customer](g), Status: "open"}
+//	})
+func WithGenFunc[T any](generator func(g *Gen) T) Option {
+	return func(cfg *Config) {
+		var typ = reflect.TypeFor[T]()
+		cfg.rules[typ] = func(g *Gen) reflect.Value {
+			return reflect.ValueOf(generator(g))
 		}
+		delete(cfg.domains, typ)
 	}
 }
 
@@ -38,13 +62,37 @@ func Values[T any, E ~[]T](values E) {
 // WithValues will pick one of the supplied values when
 // generating a value of type T
 func WithValues[T any, E ~[]T](values E) Option {
-	return WithGenerator(func(r *rand.Rand) T {
+	var generator = WithGenerator(func(r *rand.Rand) T {
 		if len(values) == 0 {
 			var t T
 			return t
 		}
 		return values[r.IntN(len(values))]
 	})
+
+	return func(cfg *Config) {
+		generator(cfg)
+		cfg.domains[reflect.TypeFor[T]()] = distinct(values)
+	}
+}
+
+// distinct counts the distinct values. If a value cannot be compared,
+// ie an interface holding a slice, all values are counted.
+func distinct[T any](values []T) int {
+	if !reflect.TypeFor[T]().Comparable() {
+		return len(values)
+	}
+
+	var seen = make(map[any]struct{}, len(values))
+	for _, v := range values {
+		if !reflect.ValueOf(&v).Elem().Comparable() {
+			return len(values)
+		}
+
+		seen[v] = struct{}{}
+	}
+
+	return len(seen)
 }
 
 // Rand will use the provided *rand.Rand when generating
@@ -55,8 +103,572 @@ func Rand(r *rand.Rand) {
 
 // WithRand will use the provided *rand.Rand when generating
 // testdata using the constructed Config.
+// The *rand.Rand is shared by all tests, so the generated values
+// depend on the order the tests are run in.
 func WithRand(r *rand.Rand) Option {
 	return func(cfg *Config) {
 		cfg.rand = r
 	}
 }
+
+// Seed sets the master seed of DefaultConfig.
+func Seed(value uint64) {
+	WithSeed(value)(DefaultConfig)
+}
+
+// WithSeed sets the master seed of the Config. Each test gets an independent
+// random stream derived from the master seed and the name of the test,
+// so a test generates the same values no matter which other tests ran before it.
+func WithSeed(value uint64) Option {
+	return func(cfg *Config) {
+		cfg.seeds = seed.New(value)
+	}
+}
+
+// MaxDepth sets how many levels of a recursive type DefaultConfig generates.
+func MaxDepth(depth int) {
+	WithMaxDepth(depth)(DefaultConfig)
+}
+
+// WithMaxDepth sets how many levels of a recursive type are generated,
+// ie a struct with a field of type *Self, []Self or map[K]*Self.
+// When the depth is reached, the recursive fields are left nil. The default is 3.
+func WithMaxDepth(depth int) Option {
+	if depth < 1 {
+		panic(fmt.Sprintf("testdata: max depth must be at least 1, got %d", depth))
+	}
+
+	return func(cfg *Config) {
+		cfg.maxDepth = depth
+	}
+}
+
+// Implementations registers the concrete types of the values as implementations
+// of the interface I for DefaultConfig.
+func Implementations[I any](impls ...I) {
+	WithImplementations(impls...)(DefaultConfig)
+}
+
+// WithImplementations registers the concrete types of the values as implementations
+// of the interface I. When a value of I is generated, one of the concrete types is
+// picked at random and generated in its place. The values themselves are only used for their type.
+func WithImplementations[I any](impls ...I) Option {
+	var typ = reflect.TypeFor[I]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("testdata: %s is not an interface", typ))
+	}
+
+	var types []reflect.Type
+	for _, impl := range impls {
+		implType := reflect.TypeOf(impl)
+		if implType == nil {
+			panic(fmt.Sprintf("testdata: nil is not an implementation of %s", typ))
+		}
+		types = append(types, implType)
+	}
+
+	return func(cfg *Config) {
+		cfg.implementations[typ] = append(cfg.implementations[typ], types...)
+	}
+}
+
+// AnyJSON fills empty interfaces with JSON values when using DefaultConfig.
+func AnyJSON(depth, breadth int) {
+	WithAnyJSON(depth, breadth)(DefaultConfig)
+}
+
+// WithAnyJSON fills values of an empty interface, ie any, with a random tree
+// of JSON compatible values: objects, arrays, numbers, strings, bools and null.
+// Objects and arrays are nested at most depth levels and have at most breadth elements.
+// Interfaces with implementations registered using WithImplementations are not affected.
+func WithAnyJSON(depth, breadth int) Option {
+	if depth < 1 || breadth < 0 {
+		panic(fmt.Sprintf("testdata: invalid JSON depth %d and breadth %d", depth, breadth))
+	}
+
+	return func(cfg *Config) {
+		cfg.jsonDepth = depth
+		cfg.jsonBreadth = breadth
+	}
+}
+
+// SliceLen sets the length of the slice type T generated by DefaultConfig.
+func SliceLen[T any](min, max int) {
+	WithSliceLen[T](min, max)(DefaultConfig)
+}
+
+// WithSliceLen sets the length of the slice type T to a random length between min and max.
+func WithSliceLen[T any](min, max int) Option {
+	return withLen(reflect.TypeFor[T](), reflect.Slice, min, max)
+}
+
+// MapLen sets the length of the map type T generated by DefaultConfig.
+func MapLen[T any](min, max int) {
+	WithMapLen[T](min, max)(DefaultConfig)
+}
+
+// WithMapLen sets the length of the map type T to a random length between min and max.
+func WithMapLen[T any](min, max int) Option {
+	return withLen(reflect.TypeFor[T](), reflect.Map, min, max)
+}
+
+func withLen(typ reflect.Type, kind reflect.Kind, min, max int) Option {
+	if typ.Kind() != kind {
+		panic(fmt.Sprintf("testdata: %s is not a %s", typ, kind))
+	}
+
+	var rng = newLengthRange(min, max)
+	return func(cfg *Config) {
+		cfg.lengths[typ] = rng
+	}
+}
+
+// StringLen sets the length of the string type T generated by DefaultConfig.
+func StringLen[T ~string](min, max int) {
+	WithStringLen[T](min, max)(DefaultConfig)
+}
+
+// WithStringLen sets the number of characters of the string type T to a random length between min and max.
+// The length does not include the prefix.
+func WithStringLen[T ~string](min, max int) Option {
+	return withLen(reflect.TypeFor[T](), reflect.String, min, max)
+}
+
+// KindLen sets the length of all strings, slices, maps or channels generated by DefaultConfig.
+func KindLen(kind reflect.Kind, min, max int) {
+	WithKindLen(kind, min, max)(DefaultConfig)
+}
+
+// WithKindLen sets the length of all strings, slices, maps or channels to a random length between min and max.
+// A length set for a specific type using WithStringLen, WithSliceLen or WithMapLen takes precedence.
+// The default length is 16 characters for strings, and 5 for the others.
+func WithKindLen(kind reflect.Kind, min, max int) Option {
+	if kind != reflect.String && kind != reflect.Slice && kind != reflect.Map && kind != reflect.Chan {
+		panic(fmt.Sprintf("testdata: length of kind %s cannot be set", kind))
+	}
+
+	var rng = newLengthRange(min, max)
+	return func(cfg *Config) {
+		cfg.kindLengths[kind] = rng
+	}
+}
+
+// NilProbability sets the probability of nil pointers, slices, maps and interfaces generated by DefaultConfig.
+func NilProbability(p float64) {
+	WithNilProbability(p)(DefaultConfig)
+}
+
+// WithNilProbability sets the probability, between 0 and 1, that a pointer, slice, map or interface is left nil.
+// It applies to values nested in the generated value, ie fields and elements, but never the value itself.
+func WithNilProbability(p float64) Option {
+	if p < 0 || p > 1 {
+		panic(fmt.Sprintf("testdata: nil probability %v is not between 0 and 1", p))
+	}
+
+	return func(cfg *Config) {
+		cfg.nilProbability = p
+	}
+}
+
+// Strict makes DefaultConfig fail the test when a value cannot be generated.
+func Strict() {
+	WithStrict()(DefaultConfig)
+}
+
+// WithStrict fails the test using t.Fatalf when a value cannot be generated,
+// ie an interface without implementations, instead of leaving it as the zero value.
+// The error names the field path of the value.
+func WithStrict() Option {
+	return func(cfg *Config) {
+		cfg.strict = true
+	}
+}
+
+// FieldGenerator will use the generator func for struct fields matching the key
+// when using DefaultConfig. See WithFieldGenerator.
+func FieldGenerator[T any](key string, generator func(r *rand.Rand) T) {
+	WithFieldGenerator(key, generator)(DefaultConfig)
+}
+
+// WithFieldGenerator will use the generator func for struct fields matching the key,
+// instead of the generator of the field type. The key is either
+//   - the name of the field, ie "Email"
+//   - a path ending in the field, ie "User.ID", where User is the name of a field or the struct type
+//   - the name of the field in a struct tag, ie "json:email"
+//
+// When several keys match a field, the longest path is used.
+// The generated value is converted to the type of the field. Fields of a type
+// T cannot be converted to are generated as usual.
+func WithFieldGenerator[T any](key string, generator func(r *rand.Rand) T) Option {
+	return WithFieldGenFunc(key, func(g *Gen) T {
+		return generator(g.Rand())
+	})
+}
+
+// FieldGenFunc will use the generator func for struct fields matching the key
+// when using DefaultConfig. See WithFieldGenFunc.
+func FieldGenFunc[T any](key string, generator func(g *Gen) T) {
+	WithFieldGenFunc(key, generator)(DefaultConfig)
+}
+
+// WithFieldGenFunc is similar to WithFieldGenerator, just with a generator
+// that gets the Gen of the field, ie to read the Path or Parent of it.
+func WithFieldGenFunc[T any](key string, generator func(g *Gen) T) Option {
+	var matcher = newFieldMatcher(key)
+	return func(cfg *Config) {
+		cfg.fieldRules = append(cfg.fieldRules, fieldRule{
+			matcher: matcher,
+			generate: func(g *Gen) reflect.Value {
+				return reflect.ValueOf(generator(g))
+			},
+			typ: reflect.TypeFor[T](),
+		})
+	}
+}
+
+// Locale sets the locale of DefaultConfig. See WithLocale.
+func Locale(locale string) {
+	WithLocale(locale)(DefaultConfig)
+}
+
+// WithLocale sets the locale of generated values, ie "da_DK". The locale is a language
+// and region separated by an underscore, and is available to generators as Gen.Locale.
+// The generators of the fake package use it for names, addresses, phone numbers and dates.
+// They support fewer locales than are accepted here, see fake.Locales, and report an error
+// on the test for the others. The default is "en_US".
+func WithLocale(locale string) Option {
+	if !localePattern.MatchString(locale) {
+		panic(fmt.Sprintf("testdata: invalid locale %q, expected a form like en_US", locale))
+	}
+
+	return func(cfg *Config) {
+		cfg.locale = locale
+	}
+}
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}_[A-Z]{2}$`)
+
+// Pattern generates values of the string type T matching the regular expression
+// when using DefaultConfig. See WithPattern.
+func Pattern[T ~string](pattern string) {
+	WithPattern[T](pattern)(DefaultConfig)
+}
+
+// WithPattern generates values of the string type T matching the regular expression,
+// ie `^[A-Z]{3}-\d{6}$`. The syntax is the one of the regexp package. The repetitions
+// *, + and {n,} add at most 8 repetitions. It panics if the pattern is invalid.
+func WithPattern[T ~string](pattern string) Option {
+	re, err := generate.ParsePattern(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("testdata: invalid pattern %q: %s", pattern, err))
+	}
+
+	return WithGenerator(func(r *rand.Rand) T {
+		return T(generate.Pattern(r, reflect.TypeFor[T](), re).String())
+	})
+}
+
+// StringCharset sets the Charset of all strings generated by DefaultConfig.
+func StringCharset(charset Charset) {
+	WithStringCharset(charset)(DefaultConfig)
+}
+
+// WithStringCharset sets the Charset of all generated strings. A Charset set for
+// a specific type using WithCharsetOf takes precedence. The default is Alphanumeric.
+func WithStringCharset(charset Charset) Option {
+	return func(cfg *Config) {
+		cfg.charset = charset
+	}
+}
+
+// CharsetOf sets the Charset of the string type T generated by DefaultConfig.
+func CharsetOf[T ~string](charset Charset) {
+	WithCharsetOf[T](charset)(DefaultConfig)
+}
+
+// WithCharsetOf sets the Charset of the string type T.
+func WithCharsetOf[T ~string](charset Charset) Option {
+	return func(cfg *Config) {
+		cfg.charsets[reflect.TypeFor[T]()] = charset
+	}
+}
+
+// StringPrefix sets the prefix of all strings generated by DefaultConfig. See WithStringPrefix.
+func StringPrefix(prefix string) {
+	WithStringPrefix(prefix)(DefaultConfig)
+}
+
+// WithStringPrefix sets the prefix of all generated strings. The placeholder {type} is
+// replaced by the name of the string type. The default is "{type}-", and an empty
+// prefix leaves it out. A prefix set for a specific type using WithPrefixOf takes precedence.
+// Strings constrained by a struct tag have no prefix.
+func WithStringPrefix(prefix string) Option {
+	return func(cfg *Config) {
+		cfg.prefix = prefix
+	}
+}
+
+// PrefixOf sets the prefix of the string type T generated by DefaultConfig. See WithStringPrefix.
+func PrefixOf[T ~string](prefix string) {
+	WithPrefixOf[T](prefix)(DefaultConfig)
+}
+
+// WithPrefixOf sets the prefix of the string type T. See WithStringPrefix.
+func WithPrefixOf[T ~string](prefix string) Option {
+	return func(cfg *Config) {
+		cfg.prefixes[reflect.TypeFor[T]()] = prefix
+	}
+}
+
+// FullRange generates numbers in the full range of their type when using DefaultConfig. See WithFullRange.
+func FullRange() {
+	WithFullRange()(DefaultConfig)
+}
+
+// WithFullRange generates numbers in the full range of their type, including negative numbers.
+// Floats are finite and have a random exponent, so both very small and very large values are generated.
+// By default, integers are not negative, and floats are between 0 and 1.
+func WithFullRange() Option {
+	return func(cfg *Config) {
+		cfg.fullRange = true
+	}
+}
+
+// EdgeCases mixes boundary values into the numbers generated by DefaultConfig. See WithEdgeCases.
+func EdgeCases(rate float64) {
+	WithEdgeCases(rate)(DefaultConfig)
+}
+
+// WithEdgeCases generates a boundary value for a number with the probability rate, between 0 and 1.
+// The boundary values are 0, 1, -1 and the smallest and largest values of the type,
+// and for floats also -0, NaN, +Inf, -Inf and the smallest non-zero values.
+// Numbers constrained by a struct tag are not affected.
+func WithEdgeCases(rate float64) Option {
+	if rate < 0 || rate > 1 {
+		panic(fmt.Sprintf("testdata: edge case rate %v is not between 0 and 1", rate))
+	}
+
+	return func(cfg *Config) {
+		cfg.edgeCases = rate
+	}
+}
+
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Range generates values of the integer type T between min and max, both included,
+// when using DefaultConfig.
+func Range[T integer](min, max T) {
+	WithRange(min, max)(DefaultConfig)
+}
+
+// WithRange generates values of the integer type T between min and max, both included.
+// It panics if min is larger than max.
+//
+//	testdata.WithRange[Age](18, 65)
+func WithRange[T integer](min, max T) Option {
+	if min > max {
+		panic(fmt.Sprintf("testdata: invalid range [%v, %v]", min, max))
+	}
+
+	var typ = reflect.TypeFor[T]()
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return WithGenerator(func(r *rand.Rand) T {
+			return T(generate.IntRange(r, typ, int64(min), int64(max)).Int())
+		})
+	default:
+		return WithGenerator(func(r *rand.Rand) T {
+			return T(generate.UintRange(r, typ, uint64(min), uint64(max)).Uint())
+		})
+	}
+}
+
+// FloatRange generates values of the float type T between min and max, rounded to
+// precision decimals, when using DefaultConfig. See WithFloatRange.
+func FloatRange[T ~float32 | ~float64](min, max T, precision int) {
+	WithFloatRange(min, max, precision)(DefaultConfig)
+}
+
+// WithFloatRange generates values of the float type T between min and max, both included,
+// rounded to precision decimals. A negative precision leaves the values unrounded.
+// It panics if the range is invalid, or no value with the precision is within it.
+//
+//	testdata.WithFloatRange[Price](0.01, 999.99, 2)
+func WithFloatRange[T ~float32 | ~float64](min, max T, precision int) Option {
+	var from, to = float64(min), float64(max)
+	if math.IsNaN(from) || math.IsNaN(to) || math.IsInf(from, 0) || math.IsInf(to, 0) || from > to {
+		panic(fmt.Sprintf("testdata: invalid float range [%v, %v]", min, max))
+	}
+
+	var typ = reflect.TypeFor[T]()
+	if precision < 0 {
+		return WithGenerator(func(r *rand.Rand) T {
+			return T(generate.FloatRange(r, typ, from, to).Float())
+		})
+	}
+
+	var (
+		scale = math.Pow10(precision)
+		lower = math.Ceil(from * scale)
+		upper = math.Floor(to * scale)
+	)
+
+	// The bounds may be just off a decimal when T is less precise than float64, ie 999.99 as float32.
+	if T((lower-1)/scale) >= min {
+		lower--
+	}
+	if T((upper+1)/scale) <= max {
+		upper++
+	}
+
+	if lower > upper || math.Abs(lower) > 1<<53 || math.Abs(upper) > 1<<53 {
+		panic(fmt.Sprintf("testdata: float range [%v, %v] has no values with precision %d", min, max, precision))
+	}
+
+	return WithGenerator(func(r *rand.Rand) T {
+		var n = generate.IntRange(r, reflect.TypeFor[int64](), int64(lower), int64(upper)).Int()
+		return T(float64(n) / scale)
+	})
+}
+
+// Clock sets the reference clock of times generated by DefaultConfig. See WithClock.
+func Clock(clock func() time.Time) {
+	WithClock(clock)(DefaultConfig)
+}
+
+// WithClock sets the reference clock of generated times, which are generated in a window
+// around it. Use a fixed clock to generate the same times with a fixed seed. The default is time.Now.
+//
+//	testdata.WithClock(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) })
+func WithClock(clock func() time.Time) Option {
+	if clock == nil {
+		panic("testdata: clock must not be nil")
+	}
+
+	return func(cfg *Config) {
+		cfg.clock = clock
+		cfg.clockSet = true
+	}
+}
+
+// TimeWindow sets the window of times generated by DefaultConfig. See WithTimeWindow.
+func TimeWindow(past, future time.Duration) {
+	WithTimeWindow(past, future)(DefaultConfig)
+}
+
+// WithTimeWindow generates times between past before and future after the clock.
+// The default is from the clock and three years into the future.
+func WithTimeWindow(past, future time.Duration) Option {
+	if past < 0 || future < 0 || past+future < 0 {
+		panic(fmt.Sprintf("testdata: invalid time window of %s in the past and %s in the future", past, future))
+	}
+
+	return func(cfg *Config) {
+		cfg.timePast = past
+		cfg.timeFuture = future
+	}
+}
+
+// TimePrecision sets the precision of times generated by DefaultConfig. See WithTimePrecision.
+func TimePrecision(precision time.Duration) {
+	WithTimePrecision(precision)(DefaultConfig)
+}
+
+// WithTimePrecision truncates generated times to a multiple of precision, ie time.Second or time.Millisecond.
+// A precision of 24 * time.Hour or more truncates to the start of the day in the location of the time.
+// The default is to keep the nanoseconds.
+func WithTimePrecision(precision time.Duration) Option {
+	if precision < 0 {
+		panic(fmt.Sprintf("testdata: invalid time precision %s", precision))
+	}
+
+	return func(cfg *Config) {
+		cfg.timePrecision = precision
+	}
+}
+
+// TimeLocations sets the locations of times generated by DefaultConfig. See WithTimeLocations.
+func TimeLocations(locations ...*time.Location) {
+	WithTimeLocations(locations...)(DefaultConfig)
+}
+
+// WithTimeLocations generates times in one of the locations. The default is time.UTC.
+//
+//	copenhagen, _ := time.LoadLocation("Europe/Copenhagen")
+//	testdata.WithTimeLocations(time.UTC, copenhagen)
+func WithTimeLocations(locations ...*time.Location) Option {
+	if len(locations) == 0 {
+		panic("testdata: at least one time location is required")
+	}
+
+	for _, loc := range locations {
+		if loc == nil {
+			panic("testdata: time location must not be nil")
+		}
+	}
+
+	var locs = append([]*time.Location{}, locations...)
+	return func(cfg *Config) {
+		cfg.timeLocations = locs
+	}
+}
+
+// DurationRange sets the range of durations generated by DefaultConfig. See WithDurationRange.
+func DurationRange(min, max time.Duration) {
+	WithDurationRange(min, max)(DefaultConfig)
+}
+
+// WithDurationRange generates values of time.Duration between min and max, both included.
+// The default is between 0 and 24 hours.
+func WithDurationRange(min, max time.Duration) Option {
+	if min > max {
+		panic(fmt.Sprintf("testdata: invalid duration range [%s, %s]", min, max))
+	}
+
+	return func(cfg *Config) {
+		cfg.durationMin = min
+		cfg.durationMax = max
+	}
+}
+
+// DurationUnit sets the unit of durations generated by DefaultConfig. See WithDurationUnit.
+func DurationUnit(unit time.Duration) {
+	WithDurationUnit(unit)(DefaultConfig)
+}
+
+// WithDurationUnit generates values of time.Duration as a multiple of unit, ie time.Second.
+// If no multiple of the unit is within the range, the minimum of the range is used. The default is time.Millisecond.
+func WithDurationUnit(unit time.Duration) Option {
+	if unit <= 0 {
+		panic(fmt.Sprintf("testdata: invalid duration unit %s", unit))
+	}
+
+	return func(cfg *Config) {
+		cfg.durationUnit = unit
+	}
+}
+
+// StickyKey binds struct fields matching the field key to the sticky key when using DefaultConfig.
+// See WithStickyKey.
+func StickyKey(fieldKey, key string) {
+	WithStickyKey(fieldKey, key)(DefaultConfig)
+}
+
+// WithStickyKey binds struct fields matching the field key to the sticky value made by MakeStickyAs
+// with the key. The field key is matched like in WithFieldGenerator, ie "Order.BuyerID".
+// A field is generated as usual, when no value is sticky by the key.
+//
+//	cfg := testdata.NewConfig(testdata.WithStickyKey("Order.BuyerID", "buyer"))
+//	buyer := testdata.MakeStickyAsWith[UserID](t, cfg, "buyer")
+func WithStickyKey(fieldKey, key string) Option {
+	if key == "" {
+		panic("testdata: empty sticky key")
+	}
+
+	var matcher = newFieldMatcher(fieldKey)
+	return func(cfg *Config) {
+		cfg.stickyKeys = append(cfg.stickyKeys, stickyKey{matcher: matcher, key: key})
+	}
+}
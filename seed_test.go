@@ -0,0 +1,90 @@
+package testdata_test
+
+import (
+	"testing"
+
+	"github.com/kyuff/testdata"
+	"github.com/kyuff/testdata/internal/assert"
+)
+
+func TestSeed(t *testing.T) {
+	t.Run("log seed on failure", func(t *testing.T) {
+		// arrange
+		var (
+			cfg   = testdata.NewConfig(testdata.WithSeed(42))
+			fake  = &fakeT{name: "TestFailure"}
+			_     = testdata.MakeWith[int](fake, cfg)
+			_     = testdata.MakeWith[string](fake, cfg)
+			token = "testdata: seed 42, replay with -testdata.seed=42 or TESTDATA_SEED=42"
+		)
+
+		fake.failed = true
+
+		// act
+		fake.done()
+
+		// assert
+		if assert.Equal(t, 1, len(fake.logs)) {
+			assert.Equal(t, token, fake.logs[0])
+		}
+	})
+
+	t.Run("no log on success", func(t *testing.T) {
+		// arrange
+		var (
+			cfg  = testdata.NewConfig(testdata.WithSeed(42))
+			fake = &fakeT{name: "TestSuccess"}
+			_    = testdata.MakeWith[int](fake, cfg)
+		)
+
+		// act
+		fake.done()
+
+		// assert
+		assert.Equal(t, 0, len(fake.logs))
+	})
+
+	t.Run("configs get their own stream", func(t *testing.T) {
+		// arrange
+		type UserID string
+		var (
+			buyer = testdata.MakeWith[UserID](t, testdata.NewConfig())
+		)
+
+		// act
+		seller := testdata.MakeWith[UserID](t, testdata.NewConfig())
+
+		// assert
+		assert.NotEqual(t, buyer, seller)
+	})
+
+	t.Run("replay from environment", func(t *testing.T) {
+		// arrange
+		t.Setenv("TESTDATA_SEED", "42")
+		var (
+			cfg      = testdata.NewConfig()
+			expected = testdata.MakeWith[int](t, testdata.NewConfig(testdata.WithSeed(42)))
+		)
+
+		// act
+		got := testdata.MakeWith[int](t, cfg)
+
+		// assert
+		assert.Equal(t, expected, got)
+	})
+
+	t.Run("explicit seed is not replayed", func(t *testing.T) {
+		// arrange
+		t.Setenv("TESTDATA_SEED", "42")
+		var (
+			cfg      = testdata.NewConfig(testdata.WithSeed(7))
+			expected = testdata.MakeWith[int](t, testdata.NewConfig(testdata.WithSeed(42)))
+		)
+
+		// act
+		got := testdata.MakeWith[int](t, cfg)
+
+		// assert
+		assert.NotEqual(t, expected, got)
+	})
+}
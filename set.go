@@ -0,0 +1,168 @@
+package testdata
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Set returns a modification that sets the field at path to value. The path is
+// the names of the fields from T separated by dots, ie "Customer.Address.City".
+// The field is set while the value is generated, so the rest of the structs on the
+// path are generated as usual. Nil pointers on the path are allocated.
+//
+// The test fails if the path does not exist on T, or value cannot be assigned to the field.
+// The modification must be passed to Make or one of its variants.
+//
+//	order := testdata.Make[Order](t, testdata.Set[Order]("Customer.Address.City", City("Oslo")))
+//
+//go:noinline
+func Set[T any](path string, value any) func(d T) T {
+	var o = &override{path: path, value: value}
+	return func(d T) T {
+		// Make recognizes the modification and recovers the override.
+		panic(o)
+	}
+}
+
+// override is the field set by a modification created by Set.
+type override struct {
+	path  string
+	value any
+}
+
+func (o *override) Error() string {
+	return fmt.Sprintf("testdata: Set(%q) must be passed to Make or one of its variants", o.path)
+}
+
+// overrides returns the overrides of the modifications created by Set, in order.
+func overrides[T any](modifications []func(d T) T) []*override {
+	var (
+		set    = reflect.ValueOf(Set[T]("", nil)).Pointer()
+		result []*override
+	)
+	for _, modification := range modifications {
+		if reflect.ValueOf(modification).Pointer() == set {
+			result = append(result, overrideOf(modification))
+		}
+	}
+
+	return result
+}
+
+// overrideOf recovers the override of a modification created by Set.
+func overrideOf[T any](modification func(d T) T) (o *override) {
+	defer func() {
+		o = recover().(*override)
+	}()
+
+	var d T
+	modification(d)
+	return nil
+}
+
+// modify applies the modifications to data. The overrides are set again in the order
+// of the modifications, so they apply to a value set by an earlier modification,
+// and a failing Set fails the test.
+func modify[T any](t testingT, data T, modifications []func(d T) T) T {
+	var set = reflect.ValueOf(Set[T]("", nil)).Pointer()
+	for _, modification := range modifications {
+		if reflect.ValueOf(modification).Pointer() != set {
+			data = modification(data)
+			continue
+		}
+
+		var (
+			o = overrideOf(modification)
+			v = reflect.ValueOf(&data).Elem()
+		)
+		if err := setPath(v, o.path, o.value); err != nil {
+			fatalf(t, "testdata: cannot set %q on %s: %s", o.path, v.Type(), err)
+		}
+	}
+
+	return data
+}
+
+// override returns the value of the last override of the field currently being generated.
+// A value that cannot be assigned to the field is left for modify to report.
+func (cfg *Config) override(g *Gen, field reflect.StructField) (reflect.Value, bool) {
+	var path = strings.Join(g.path, ".")
+	for i := len(g.overrides) - 1; i >= 0; i-- {
+		if g.overrides[i].path != path {
+			continue
+		}
+
+		var v = reflect.New(field.Type).Elem()
+		if err := assign(v, g.overrides[i].value); err != nil {
+			return reflect.Value{}, false
+		}
+
+		return v, true
+	}
+
+	return reflect.Value{}, false
+}
+
+func setPath(v reflect.Value, path string, value any) error {
+	for _, name := range strings.Split(path, ".") {
+		v = allocate(v)
+		if v.Kind() != reflect.Struct {
+			return fmt.Errorf("%s is not a struct", v.Type())
+		}
+
+		field, ok := v.Type().FieldByName(name)
+		if !ok {
+			return fmt.Errorf("unknown field %s on %s", name, v.Type())
+		}
+
+		if !field.IsExported() {
+			return fmt.Errorf("field %s on %s is not exported", name, v.Type())
+		}
+
+		for i, index := range field.Index {
+			if i > 0 {
+				v = allocate(v)
+			}
+			v = v.Field(index)
+		}
+	}
+
+	return assign(v, value)
+}
+
+// allocate follows pointers, allocating them when nil.
+func allocate(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	return v
+}
+
+func assign(field reflect.Value, value any) error {
+	if value == nil {
+		switch field.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		default:
+			return fmt.Errorf("cannot use nil as %s", field.Type())
+		}
+	}
+
+	var v = reflect.ValueOf(value)
+	switch {
+	case v.Type().AssignableTo(field.Type()):
+		field.Set(v)
+	case v.Kind() == field.Kind() && v.Type().ConvertibleTo(field.Type()):
+		field.Set(v.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot use %s as %s", v.Type(), field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package testdata
+
+import (
+	"reflect"
+	"strings"
+)
+
+// defaultPrefix of generated strings. The placeholder {type} is replaced by the name of the type.
+const defaultPrefix = "{type}-"
+
+func (cfg *Config) charsetOf(typ reflect.Type) Charset {
+	if charset, ok := cfg.charsets[typ]; ok {
+		return charset
+	}
+
+	return cfg.charset
+}
+
+func (cfg *Config) prefixOf(typ reflect.Type) string {
+	var prefix, ok = cfg.prefixes[typ]
+	if !ok {
+		prefix = cfg.prefix
+	}
+
+	return strings.ReplaceAll(prefix, "{type}", typ.Name())
+}
+
+// generateString of typ with the prefix, charset and length configured for it.
+func (cfg *Config) generateString(g *Gen, typ reflect.Type) reflect.Value {
+	var size, _ = cfg.length(g, typ)
+	return reflect.ValueOf(cfg.prefixOf(typ) + cfg.charsetOf(typ).chars(g.rand, size))
+}
@@ -0,0 +1,298 @@
+package testdata
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"reflect"
+	"strconv"
+
+	"github.com/kyuff/testdata/internal/generate"
+	"github.com/kyuff/testdata/internal/tag"
+)
+
+// nonZeroAttempts is the number of times a nonzero field is generated
+// before giving up.
+const nonZeroAttempts = 100
+
+func (cfg *Config) makeTagged(g *Gen, field reflect.StructField, fieldTag tag.Tag) reflect.Value {
+	switch {
+	case fieldTag.Skip:
+		return reflect.Value{}
+	case fieldTag.Zero, cfg.tooDeep(g, field.Type):
+		return reflect.Zero(field.Type)
+	}
+
+	var makeValue = func() (reflect.Value, error) {
+		if !fieldTag.Constrained() {
+			return cfg.make(g, field.Type), nil
+		}
+
+		return cfg.makeConstrained(g, field.Type, fieldTag)
+	}
+
+	v, err := makeValue()
+	for i := 0; err == nil && fieldTag.NonZero && v.IsZero() && i < nonZeroAttempts; i++ {
+		v, err = makeValue()
+	}
+
+	if err != nil {
+		fatalf(g.t, "testdata: field %s: %s", field.Name, err)
+		return reflect.Zero(field.Type)
+	}
+
+	return v
+}
+
+func (cfg *Config) makeConstrained(g *Gen, typ reflect.Type, fieldTag tag.Tag) (reflect.Value, error) {
+	if typ.Kind() == reflect.Pointer {
+		v, err := cfg.makeConstrained(g, typ.Elem(), fieldTag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return generate.Pointer(v), nil
+	}
+
+	var r = g.rand
+	if fieldTag.Pattern != "" {
+		return pattern(r, typ, fieldTag.Pattern)
+	}
+
+	if len(fieldTag.OneOf) > 0 {
+		return oneOf(r, typ, fieldTag.OneOf)
+	}
+
+	var maker = func(typ reflect.Type) reflect.Value {
+		return cfg.make(g, typ)
+	}
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if fieldTag.Len != "" {
+			break
+		}
+		lower, upper := generate.IntBounds(typ)
+		from, err := parseInt(fieldTag.Min, typ, lower)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		to, err := parseInt(fieldTag.Max, typ, upper)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if from > to {
+			return reflect.Value{}, fmt.Errorf("min %d is larger than max %d", from, to)
+		}
+		return generate.IntRange(r, typ, from, to), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if fieldTag.Len != "" {
+			break
+		}
+		lower, upper := generate.UintBounds(typ)
+		from, err := parseUint(fieldTag.Min, typ, lower)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		to, err := parseUint(fieldTag.Max, typ, upper)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if from > to {
+			return reflect.Value{}, fmt.Errorf("min %d is larger than max %d", from, to)
+		}
+		return generate.UintRange(r, typ, from, to), nil
+
+	case reflect.Float32, reflect.Float64:
+		if fieldTag.Len != "" {
+			break
+		}
+		from, to, err := parseFloatRange(fieldTag.Min, fieldTag.Max, typ)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return generate.FloatRange(r, typ, from, to), nil
+
+	case reflect.String:
+		size, err := parseLen(r, fieldTag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(cfg.charsetOf(typ).chars(r, size)), nil
+
+	case reflect.Slice:
+		size, err := parseLen(r, fieldTag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return generate.Slice(typ, maker, size), nil
+
+	case reflect.Map:
+		size, err := parseLen(r, fieldTag)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return cfg.generateMap(g, typ, size, true), nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("constraints are not supported for type %s", typ)
+}
+
+func pattern(r *rand.Rand, typ reflect.Type, expr string) (reflect.Value, error) {
+	if typ.Kind() != reflect.String {
+		return reflect.Value{}, fmt.Errorf("pattern is not supported for type %s", typ)
+	}
+
+	re, err := generate.ParsePattern(expr)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return generate.Pattern(r, typ, re), nil
+}
+
+func oneOf(r *rand.Rand, typ reflect.Type, values []string) (reflect.Value, error) {
+	var (
+		s   = values[r.IntN(len(values))]
+		val = reflect.New(typ).Elem()
+	)
+
+	switch typ.Kind() {
+	case reflect.String:
+		val.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("oneof value %q: %w", s, err)
+		}
+		val.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 0, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("oneof value %q: %w", s, err)
+		}
+		val.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 0, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("oneof value %q: %w", s, err)
+		}
+		val.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("oneof value %q: %w", s, err)
+		}
+		val.SetFloat(f)
+	default:
+		return reflect.Value{}, fmt.Errorf("oneof is not supported for type %s", typ)
+	}
+
+	return val, nil
+}
+
+// parseLen returns the exact length of a len constraint, or a random
+// length within the bounds of min and max.
+func parseLen(r *rand.Rand, fieldTag tag.Tag) (int, error) {
+	if fieldTag.Len != "" {
+		return parseSize("len", fieldTag.Len, 0)
+	}
+
+	from, err := parseSize("min", fieldTag.Min, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	to, err := parseSize("max", fieldTag.Max, max(from, 5))
+	if err != nil {
+		return 0, err
+	}
+
+	if from > to {
+		return 0, fmt.Errorf("min %d is larger than max %d", from, to)
+	}
+
+	return from + r.IntN(to-from+1), nil
+}
+
+func parseSize(name, s string, fallback int) (int, error) {
+	if s == "" {
+		return fallback, nil
+	}
+
+	size, err := strconv.Atoi(s)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("%s %q is not a valid length", name, s)
+	}
+
+	return size, nil
+}
+
+func parseInt(s string, typ reflect.Type, fallback int64) (int64, error) {
+	if s == "" {
+		return fallback, nil
+	}
+
+	i, err := strconv.ParseInt(s, 0, typ.Bits())
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid %s", s, typ)
+	}
+
+	return i, nil
+}
+
+func parseUint(s string, typ reflect.Type, fallback uint64) (uint64, error) {
+	if s == "" {
+		return fallback, nil
+	}
+
+	u, err := strconv.ParseUint(s, 0, typ.Bits())
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid %s", s, typ)
+	}
+
+	return u, nil
+}
+
+func parseFloatRange(minText, maxText string, typ reflect.Type) (float64, float64, error) {
+	var (
+		from, to   float64
+		err        error
+		hasMin     = minText != ""
+		hasMax     = maxText != ""
+		parseFloat = func(s string) (float64, error) {
+			f, err := strconv.ParseFloat(s, typ.Bits())
+			if err != nil {
+				return 0, fmt.Errorf("%q is not a valid %s", s, typ)
+			}
+			return f, nil
+		}
+	)
+
+	if hasMin {
+		if from, err = parseFloat(minText); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if hasMax {
+		if to, err = parseFloat(maxText); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	switch {
+	case !hasMin && !hasMax:
+		to = 1
+	case !hasMin:
+		from = min(0, to-1)
+	case !hasMax:
+		to = max(1, from+1)
+	}
+
+	if from > to {
+		return 0, 0, fmt.Errorf("min %v is larger than max %v", from, to)
+	}
+
+	return from, to, nil
+}
@@ -0,0 +1,47 @@
+package testdata_test
+
+import (
+	"fmt"
+)
+
+// fakeT records what is reported to it, so tests can assert on it.
+type fakeT struct {
+	name     string
+	failed   bool
+	logs     []string
+	errors   []string
+	cleanups []func()
+}
+
+func (t *fakeT) Name() string {
+	return t.name
+}
+
+func (t *fakeT) Cleanup(fn func()) {
+	t.cleanups = append(t.cleanups, fn)
+}
+
+func (t *fakeT) Failed() bool {
+	return t.failed
+}
+
+func (t *fakeT) Logf(format string, args ...any) {
+	t.logs = append(t.logs, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.failed = true
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) done() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
@@ -0,0 +1,51 @@
+package testdata
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+const (
+	// defaultTimeFuture is how far into the future times are generated by default.
+	defaultTimeFuture = 3 * 365 * 24 * time.Hour
+	// day is the precision truncating times to the start of the day in their location.
+	day = 24 * time.Hour
+	// defaultDurationMax is the longest duration generated by default.
+	defaultDurationMax = day
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	locationType = reflect.TypeOf(&time.Location{})
+)
+
+// generateTime within the window around the clock of the Config.
+func (cfg *Config) generateTime(g *Gen, typ reflect.Type) reflect.Value {
+	var (
+		from = cfg.clock().Add(-cfg.timePast)
+		span = cfg.timePast + cfg.timeFuture
+	)
+
+	var offset time.Duration
+	if span == math.MaxInt64 {
+		offset = time.Duration(g.rand.Int64())
+	} else {
+		offset = time.Duration(g.rand.Int64N(int64(span) + 1))
+	}
+
+	var (
+		loc = cfg.timeLocations[g.rand.IntN(len(cfg.timeLocations))]
+		t   = from.Add(offset).In(loc)
+	)
+
+	switch {
+	case cfg.timePrecision >= day:
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	case cfg.timePrecision > 0:
+		t = t.Truncate(cfg.timePrecision)
+	}
+
+	return reflect.ValueOf(t).Convert(typ)
+}